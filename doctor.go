@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
+)
+
+// runDoctor runs the doctor subcommand, which checks that the provider can
+// connect to and authenticate with a host without running Terraform. It
+// reads the same environment variables and Terraform CLI configuration as the
+// provider, and returns the exit code.
+func runDoctor(args []string) int {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-tfe doctor [options]\n\n"+
+			"Checks the configuration, connectivity and authentication of the provider for a host.\n\n")
+		flags.PrintDefaults()
+	}
+
+	opts := clientFlags(flags)
+	organization := flags.String("organization", os.Getenv("TFE_ORGANIZATION"), "An organization to read the entitlements of. Defaults to TFE_ORGANIZATION.")
+	jsonFlag := flags.Bool("json", false, "Print the report as JSON.")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	// The client logs as it would to Terraform, which only clutters the
+	// report unless logging is requested.
+	if os.Getenv("TF_LOG") == "" {
+		log.SetOutput(io.Discard)
+	}
+
+	report := client.Diagnose(context.Background(), opts, *organization)
+
+	if *jsonFlag {
+		if err := report.WriteJSON(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write the report: %v\n", err)
+			return 1
+		}
+	} else {
+		report.WriteText(os.Stdout)
+	}
+
+	if report.Failed() {
+		return 1
+	}
+	return 0
+}
+
+// clientFlags adds the flags that configure the client of a subcommand. Any
+// setting without a flag is read from the environment, as the provider does.
+func clientFlags(flags *flag.FlagSet) *client.ClientOptions {
+	opts := &client.ClientOptions{}
+	flags.StringVar(&opts.Hostname, "hostname", "", "The host to connect to. Defaults to TFE_HOSTNAME, or app.terraform.io.")
+	flags.StringVar(&opts.TokenFile, "token-file", "", "A file containing the token. Defaults to TFE_TOKEN_FILE, TFE_TOKEN, or the Terraform CLI credentials.")
+	flags.BoolVar(&opts.SSLSkipVerify, "ssl-skip-verify", false, "Skip verifying the certificate of the host.")
+	flags.StringVar(&opts.CACertFile, "ca-cert-file", "", "A file of additional certificate authorities to trust.")
+	return opts
+}
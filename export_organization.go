@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
+	"github.com/hashicorp/terraform-provider-tfe/internal/provider"
+)
+
+// runExportOrganization runs the export-organization subcommand, which
+// writes the configuration of the resources of an organization, and the
+// import blocks to bring them under management, to a directory. It returns
+// the exit code.
+func runExportOrganization(ctx context.Context, args []string) int {
+	flags := flag.NewFlagSet("export-organization", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-tfe export-organization -organization NAME [options]\n\n"+
+			"Writes the configuration of the resources of an organization, with import blocks, to a directory.\n\n")
+		flags.PrintDefaults()
+	}
+
+	opts := clientFlags(flags)
+	organization := flags.String("organization", os.Getenv("TFE_ORGANIZATION"), "The organization to export. Defaults to TFE_ORGANIZATION.")
+	output := flags.String("output", ".", "The directory to write the .tf files to. Existing files are never overwritten.")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *organization == "" {
+		fmt.Fprintln(os.Stderr, "An organization is required, either with -organization or TFE_ORGANIZATION.")
+		return 2
+	}
+
+	// The client logs as it would to Terraform, which only clutters the
+	// output unless logging is requested.
+	if os.Getenv("TF_LOG") == "" {
+		log.SetOutput(io.Discard)
+	}
+
+	if err := exportOrganization(ctx, opts, *organization, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to export organization %s: %v\n", *organization, err)
+		return 1
+	}
+	return 0
+}
+
+func exportOrganization(ctx context.Context, opts *client.ClientOptions, organization, output string) error {
+	tfeClient, err := client.GetClient(opts)
+	if err != nil {
+		return err
+	}
+
+	server, err := providerServer(ctx)
+	if err != nil {
+		return err
+	}
+
+	files, err := provider.ExportOrganization(ctx, server(), tfeClient, organization)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+		if _, err := os.Stat(filepath.Join(output, name)); !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%s already exists", filepath.Join(output, name))
+		}
+	}
+	sort.Strings(names)
+
+	if err := os.MkdirAll(output, 0o755); err != nil {
+		return err
+	}
+	for _, name := range names {
+		path := filepath.Join(output, name)
+		if err := os.WriteFile(path, files[name], 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %s\n", path)
+	}
+
+	fmt.Printf("\nReview the plan of terraform apply in %s to import the resources, then remove %s.\n", output, provider.ExportImportsFile)
+	return nil
+}
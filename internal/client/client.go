@@ -32,6 +32,10 @@ var (
 type ClientConfigMap struct {
 	mu     sync.Mutex
 	values map[string]*tfe.Client
+
+	// sources maps the source key of each cached configuration to its key, in
+	// order to evict clients holding a token that has since been rotated.
+	sources map[string]string
 }
 
 func (c *ClientConfigMap) GetByConfig(config *ClientConfiguration) *tfe.Client {
@@ -54,7 +58,15 @@ func (c *ClientConfigMap) Set(client *tfe.Client, config *ClientConfiguration) {
 	if c.mu.TryLock() {
 		defer c.Unlock()
 	}
-	c.values[config.Key()] = client
+
+	key, sourceKey := config.Key(), config.sourceKey()
+	if stale, ok := c.sources[sourceKey]; ok && stale != key {
+		log.Printf("[DEBUG] Evicting cached client for %s as its token was rotated", config.TFEHost)
+		delete(c.values, stale)
+	}
+
+	c.values[key] = client
+	c.sources[sourceKey] = key
 }
 
 func getTokenFromEnv() string {
@@ -81,8 +93,8 @@ func getTokenFromCreds(services *disco.Disco, hostname svchost.Hostname) string
 //
 // Internally, this function caches configured clients using the specified
 // parameters
-func GetClient(tfeHost, token string, insecure bool) (*tfe.Client, error) {
-	config, err := configure(tfeHost, token, insecure)
+func GetClient(opts *ClientOptions) (*tfe.Client, error) {
+	config, err := configure(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +137,7 @@ func GetClient(tfeHost, token string, insecure bool) (*tfe.Client, error) {
 		}
 	}
 
-	if providerVersion.ProviderVersion != "dev" {
+	if providerVersion.ProviderVersion != "dev" && !config.Discovery.SkipDiscovery {
 		// We purposefully ignore the error and return the previous error, as
 		// checking for version constraints is considered optional.
 		constraints, _ := host.VersionConstraints(tfeServiceIDs[0], "tfe-provider")
@@ -154,7 +166,9 @@ func GetClient(tfeHost, token string, insecure bool) (*tfe.Client, error) {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 
-	client.RetryServerErrors(true)
+	// Retries are handled by the retry transport configured on the HTTP
+	// client, so that the retry policy set in the provider block is honored.
+	client.RetryServerErrors(false)
 	clientCache.Set(client, config)
 
 	return client, nil
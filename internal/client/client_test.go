@@ -12,6 +12,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-tfe"
@@ -138,7 +139,7 @@ credentials "%s" {
 			t.Setenv(k, v)
 		}
 		// Must always skip SSL verification for this test server
-		client, err := GetClient(c.hostname, c.token, true)
+		client, err := GetClient(&ClientOptions{Hostname: c.hostname, Token: c.token, SSLSkipVerify: true})
 		if c.expectMissingAuth {
 			if !errors.Is(err, ErrMissingAuthToken) {
 				t.Errorf("Expected ErrMissingAuthToken, got %v", err)
@@ -160,3 +161,53 @@ credentials "%s" {
 		}
 	}
 }
+
+func TestClientConfiguration_Key(t *testing.T) {
+	config := ClientConfiguration{TFEHost: "app.terraform.io", Token: testToken}
+
+	if strings.Contains(config.Key(), testToken) {
+		t.Fatal("Expected the key not to contain the token")
+	}
+
+	other := config
+	other.Token = "another-token"
+	if config.Key() == other.Key() {
+		t.Fatal("Expected different tokens to produce different keys")
+	}
+	if config.sourceKey() == other.sourceKey() {
+		t.Fatal("Expected different static tokens to produce different source keys")
+	}
+
+	rotated := config
+	rotated.TokenSourceID = "file:/run/secrets/token"
+	rotatedAgain := rotated
+	rotatedAgain.Token = "another-token"
+	if rotated.Key() == rotatedAgain.Key() {
+		t.Fatal("Expected a rotated token to produce a different key")
+	}
+	if rotated.sourceKey() != rotatedAgain.sourceKey() {
+		t.Fatal("Expected a rotated token to keep the same source key")
+	}
+}
+
+func TestClientConfigMap_evictsRotatedTokens(t *testing.T) {
+	cache := &ClientConfigMap{
+		values:  make(map[string]*tfe.Client),
+		sources: make(map[string]string),
+	}
+
+	static := &ClientConfiguration{TFEHost: "app.terraform.io", Token: "static-token"}
+	before := &ClientConfiguration{TFEHost: "app.terraform.io", Token: "old-token", TokenSourceID: "file:/token"}
+	after := &ClientConfiguration{TFEHost: "app.terraform.io", Token: "new-token", TokenSourceID: "file:/token"}
+
+	cache.Set(&tfe.Client{}, static)
+	cache.Set(&tfe.Client{}, before)
+	cache.Set(&tfe.Client{}, after)
+
+	if cache.GetByConfig(before) != nil {
+		t.Fatal("Expected the client holding the rotated token to be evicted")
+	}
+	if cache.GetByConfig(after) == nil || cache.GetByConfig(static) == nil {
+		t.Fatal("Expected the current clients to remain cached")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// queueTimeLogThreshold is how long a request must wait for a slot before the
+// wait is logged.
+const queueTimeLogThreshold = 10 * time.Millisecond
+
+var (
+	concurrencyLimitersMu sync.Mutex
+	// concurrencyLimiters holds the semaphores of every host and limit, so
+	// that clients for the same host, such as ones using different tokens,
+	// share the limit.
+	concurrencyLimiters = map[string]chan struct{}{}
+)
+
+// concurrencyLimiter returns the semaphore limiting the requests to host to
+// limit at a time.
+func concurrencyLimiter(host string, limit int) chan struct{} {
+	concurrencyLimitersMu.Lock()
+	defer concurrencyLimitersMu.Unlock()
+
+	key := fmt.Sprintf("%s/%d", host, limit)
+	if sem, ok := concurrencyLimiters[key]; ok {
+		return sem
+	}
+
+	sem := make(chan struct{}, limit)
+	concurrencyLimiters[key] = sem
+	return sem
+}
+
+// concurrencyTransport limits the number of requests in flight at a time. It
+// sits below the retry transport, so requests waiting to be retried don't
+// hold on to a slot.
+type concurrencyTransport struct {
+	sem      chan struct{}
+	delegate http.RoundTripper
+}
+
+func newConcurrencyTransport(sem chan struct{}, delegate http.RoundTripper) *concurrencyTransport {
+	return &concurrencyTransport{sem: sem, delegate: delegate}
+}
+
+func (t *concurrencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.sem }()
+
+	if queued := time.Since(start); queued >= queueTimeLogThreshold {
+		log.Printf("[DEBUG] %s %s: waited %s for one of %d request slots", req.Method, req.URL.Path, queued, cap(t.sem))
+	}
+
+	return t.delegate.RoundTrip(req)
+}
+
+// resolveMaxConcurrentRequests returns the maximum number of requests in flight
+// at a time from the provider configuration or TFE_MAX_CONCURRENT_REQUESTS, or
+// 0 when they aren't limited.
+func resolveMaxConcurrentRequests(opts *ClientOptions) (int, error) {
+	if opts.MaxConcurrentRequests != nil {
+		if *opts.MaxConcurrentRequests < 0 {
+			return 0, fmt.Errorf("max_concurrent_requests must not be negative, got %d", *opts.MaxConcurrentRequests)
+		}
+		return *opts.MaxConcurrentRequests, nil
+	}
+
+	v := os.Getenv("TFE_MAX_CONCURRENT_REQUESTS")
+	if v == "" {
+		return 0, nil
+	}
+
+	limit, err := strconv.Atoi(v)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("TFE_MAX_CONCURRENT_REQUESTS has unrecognized value %q", v)
+	}
+
+	return limit, nil
+}
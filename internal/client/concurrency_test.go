@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrencyTransport_limitsRequestsInFlight(t *testing.T) {
+	var inFlight, maxInFlight int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	t.Cleanup(srv.Close)
+
+	// Two transports for the same host and limit share the semaphore, like
+	// the clients of different organizations would.
+	sem := concurrencyLimiter("limited.example.com", 2)
+	if other := concurrencyLimiter("limited.example.com", 2); other != sem {
+		t.Fatal("expected clients for the same host to share a semaphore")
+	}
+	transports := []http.RoundTripper{
+		newConcurrencyTransport(sem, http.DefaultTransport),
+		newConcurrencyTransport(concurrencyLimiter("limited.example.com", 2), http.DefaultTransport),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(transport http.RoundTripper) {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", srv.URL, nil)
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}(transports[i%2])
+	}
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestConcurrencyTransport_cancelledWhileQueued(t *testing.T) {
+	sem := make(chan struct{}, 1)
+	sem <- struct{}{}
+	transport := newConcurrencyTransport(sem, http.DefaultTransport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", "http://localhost", nil)
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to give up waiting for a slot, got %v", err)
+	}
+}
+
+func TestResolveMaxConcurrentRequests(t *testing.T) {
+	five, negative := 5, -1
+
+	cases := map[string]struct {
+		opts      ClientOptions
+		env       string
+		expected  int
+		expectErr bool
+	}{
+		"unlimited by default":  {},
+		"from the attribute":    {opts: ClientOptions{MaxConcurrentRequests: &five}, env: "10", expected: 5},
+		"from the environment":  {env: "10", expected: 10},
+		"negative attribute":    {opts: ClientOptions{MaxConcurrentRequests: &negative}, expectErr: true},
+		"malformed environment": {env: "lots", expectErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TFE_MAX_CONCURRENT_REQUESTS", tc.env)
+
+			limit, err := resolveMaxConcurrentRequests(&tc.opts)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if limit != tc.expected {
+				t.Fatalf("expected %d, got %d", tc.expected, limit)
+			}
+		})
+	}
+}
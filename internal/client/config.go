@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/hcl"
@@ -30,8 +31,9 @@ type CredentialsMap map[string]map[string]interface{}
 
 // CLIHostConfig is the structure of the configuration for the Terraform CLI.
 type CLIHostConfig struct {
-	Hosts       map[string]*ConfigHost `hcl:"host"`
-	Credentials CredentialsMap         `hcl:"credentials"`
+	Hosts              map[string]*ConfigHost              `hcl:"host"`
+	Credentials        CredentialsMap                      `hcl:"credentials"`
+	CredentialsHelpers map[string]*ConfigCredentialsHelper `hcl:"credentials_helper"`
 }
 
 // ConfigHost is the structure of the "host" nested block within the CLI
@@ -41,6 +43,61 @@ type ConfigHost struct {
 	Services map[string]interface{} `hcl:"services"`
 }
 
+// ClientOptions are the provider-level settings used to configure a tfe.Client.
+// Any value left unset falls back to the environment, the Terraform CLI
+// configuration or a default.
+type ClientOptions struct {
+	Hostname      string
+	Token         string
+	SSLSkipVerify bool
+
+	// TokenFile is the path of a file containing the token. The file is read
+	// again whenever the API rejects the token, so it can be rotated on disk.
+	TokenFile string
+
+	// MaxRetries and RetryServerErrors are pointers because their zero values
+	// are meaningful and must not be mistaken for an unset attribute.
+	MaxRetries        *int
+	RetryWaitMin      string
+	RetryWaitMax      string
+	RetryServerErrors *bool
+
+	// CACertFile and CACertPEM add certificate authorities to the system pool.
+	// ClientCert and ClientKey are either PEM encoded or a path to a PEM file.
+	CACertFile string
+	CACertPEM  string
+	ClientCert string
+	ClientKey  string
+
+	// ReadCacheTTL is how long successful GET responses are cached, as a
+	// duration string. Reads aren't cached or deduplicated when it's unset.
+	ReadCacheTTL string
+
+	// MaxConcurrentRequests is the maximum number of requests in flight at a
+	// time to the host, shared by every client. Requests aren't limited when
+	// it's unset or 0.
+	MaxConcurrentRequests *int
+
+	// APIURL and ServiceOverrides replace the services discovered for the host,
+	// and SkipDiscovery skips service discovery and the version constraints
+	// check altogether.
+	APIURL           string
+	ServiceOverrides map[string]string
+	SkipDiscovery    *bool
+
+	// ReadOnly rejects every request other than GET, so that no changes can
+	// be made with the client.
+	ReadOnly *bool
+
+	// WorkloadIdentityTokenFile, WorkloadIdentityAuth and
+	// WorkloadIdentityExchangeURL configure workload identity authentication,
+	// which is used when no token is set. Unset values fall back to the
+	// TFE_WORKLOAD_IDENTITY_* environment variables.
+	WorkloadIdentityTokenFile   string
+	WorkloadIdentityAuth        *bool
+	WorkloadIdentityExchangeURL string
+}
+
 // ClientConfiguration is the refined information needed to configure a tfe.Client
 type ClientConfiguration struct {
 	Services   *disco.Disco
@@ -48,11 +105,60 @@ type ClientConfiguration struct {
 	TFEHost    svchost.Hostname
 	Token      string
 	Insecure   bool
+	Retry      RetryConfig
+	TLS        TLSConfig
+
+	// ReadCacheTTL is how long successful GET responses are cached, or 0
+	// when reads aren't cached.
+	ReadCacheTTL time.Duration
+
+	// MaxConcurrentRequests is the maximum number of requests in flight at a
+	// time, or 0 when they aren't limited.
+	MaxConcurrentRequests int
+
+	// Discovery replaces service discovery for the host when it's bypassed.
+	Discovery DiscoveryConfig
+
+	// ReadOnly is whether requests other than GET are rejected.
+	ReadOnly bool
+
+	// TokenSourceID identifies where a token that can change over the lifetime
+	// of the client comes from, such as the path of a token file. It is empty
+	// for static tokens.
+	TokenSourceID string
 }
 
 // Key returns a string that is comparable to other ClientConfiguration values
 func (c ClientConfiguration) Key() string {
-	return fmt.Sprintf("%x %s/%v", sha256.New().Sum([]byte(c.Token)), c.TFEHost, c.Insecure)
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(c.sourceKey()+"\x00"+c.Token)))
+}
+
+// sourceKey digests everything that identifies a client except for the current
+// value of a rotating token. Two configurations with the same sourceKey but a
+// different Key only differ by a rotated token, so the older one is stale.
+func (c ClientConfiguration) sourceKey() string {
+	tokenSource := c.TokenSourceID
+	if tokenSource == "" {
+		tokenSource = "static:" + c.Token
+	}
+
+	h := sha256.New()
+	for _, part := range []string{
+		c.TFEHost.String(),
+		strconv.FormatBool(c.Insecure),
+		fmt.Sprintf("%+v", c.Retry),
+		c.ReadCacheTTL.String(),
+		strconv.Itoa(c.MaxConcurrentRequests),
+		fmt.Sprintf("%+v", c.Discovery),
+		strconv.FormatBool(c.ReadOnly),
+		c.TLS.digest(),
+		tokenSource,
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
 // cliConfig tries to find and parse the configuration of the Terraform CLI.
@@ -79,8 +185,10 @@ func cliConfig() CLIHostConfig {
 		credentialsConfig = readCliConfigFile(credentialsFilePath)
 	}
 
-	// Use host service discovery configs from main config file.
+	// Use host service discovery configs and the credentials helper from main
+	// config file.
 	combinedConfig.Hosts = mainConfig.Hosts
+	combinedConfig.CredentialsHelpers = mainConfig.CredentialsHelpers
 
 	// Combine both sets of credentials. Per Terraform's own behavior, the main
 	// config file overrides the credentials file if they have any overlapping
@@ -143,7 +251,19 @@ func readCliConfigFile(configFilePath string) CLIHostConfig {
 	return config
 }
 
-func credentialsSource(credentials CredentialsMap) auth.CredentialsSource {
+func credentialsSource(config CLIHostConfig) auth.CredentialsSource {
+	creds := staticCredentialsSource(config.Credentials)
+
+	// Per Terraform's own behavior, credentials configured statically take
+	// precedence over the ones returned by a credentials helper.
+	if helper := credentialsHelperSource(config.CredentialsHelpers); helper != nil {
+		creds = auth.CachingCredentialsSource(auth.Credentials{creds, helper})
+	}
+
+	return creds
+}
+
+func staticCredentialsSource(credentials CredentialsMap) auth.CredentialsSource {
 	creds := auth.NoCredentials
 
 	// Add all configured credentials to the credentials source.
@@ -164,9 +284,9 @@ func credentialsSource(credentials CredentialsMap) auth.CredentialsSource {
 	return creds
 }
 
-// configure accepts the provider-level configuration values and creates a
-// clientConfiguration using fallback values from the environment or CLI configuration.
-func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, error) {
+// resolveHostname parses the configured hostname for comparison, falling back
+// to TFE_HOSTNAME and then the default hostname.
+func resolveHostname(tfeHost string) (svchost.Hostname, error) {
 	if tfeHost == "" {
 		if os.Getenv("TFE_HOSTNAME") != "" {
 			tfeHost = os.Getenv("TFE_HOSTNAME")
@@ -174,13 +294,31 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 			tfeHost = DefaultHostname
 		}
 	}
-	log.Printf("[DEBUG] Configuring client for host %q", tfeHost)
+
+	hostname, err := svchost.ForComparison(tfeHost)
+	if err != nil {
+		return "", fmt.Errorf("invalid hostname %q: %w", tfeHost, err)
+	}
+
+	return hostname, nil
+}
+
+// configure accepts the provider-level configuration values and creates a
+// clientConfiguration using fallback values from the environment or CLI configuration.
+func configure(opts *ClientOptions) (*ClientConfiguration, error) {
+	token, insecure := opts.Token, opts.SSLSkipVerify
+
+	// Parse the hostname for comparison,
+	hostname, err := resolveHostname(opts.Hostname)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Configuring client for host %q", hostname.ForDisplay())
 
 	// If ssl_skip_verify is false, it is either set that way in configuration or unset. Check
 	// the environment to see if it was set to true there.  Strictly speaking, this means that
 	// the env var can override an explicit 'false' in configuration (which is not true of the
 	// other settings), but that's how it goes with a boolean zero value.
-	var err error
 	if !insecure && os.Getenv("TFE_SSL_SKIP_VERIFY") != "" {
 		v := os.Getenv("TFE_SSL_SKIP_VERIFY")
 		insecure, err = strconv.ParseBool(v)
@@ -194,12 +332,6 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 		log.Printf("[DEBUG] Warning: Client configured to skip certificate verifications")
 	}
 
-	// Parse the hostname for comparison,
-	hostname, err := svchost.ForComparison(tfeHost)
-	if err != nil {
-		return nil, fmt.Errorf("invalid hostname %q: %w", tfeHost, err)
-	}
-
 	httpClient := tfe.DefaultConfig().HTTPClient
 
 	// Make sure the transport has a TLS config.
@@ -210,14 +342,51 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 
 	transport.TLSClientConfig.InsecureSkipVerify = insecure
 
+	tlsConfig, err := resolveTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := tlsConfig.apply(transport.TLSClientConfig); err != nil {
+		return nil, err
+	}
+
+	retry, err := resolveRetryConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	readCacheTTL, err := durationOrEnv(opts.ReadCacheTTL, "read_cache_ttl", "TFE_READ_CACHE_TTL", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxConcurrentRequests, err := resolveMaxConcurrentRequests(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	discovery, err := resolveDiscoveryConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	readOnly, err := ReadOnly(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record or replay API interactions, including service discovery, when
+	// TFE_HTTP_RECORD or TFE_HTTP_REPLAY is set.
+	cassette := logging.NewCassetteTransport(transport)
+
 	// Get the Terraform CLI configuration.
 	config := cliConfig()
 
 	// Create a new credential source and service discovery object.
-	credsSrc := credentialsSource(config.Credentials)
+	credsSrc := credentialsSource(config)
 	services := disco.NewWithCredentialsSource(credsSrc)
 	services.SetUserAgent(TFEUserAgent)
-	services.Transport = logging.NewLoggingTransport("TFE", transport)
+	services.Transport = logging.NewLoggingTransport("TFE", cassette)
 
 	// Add any static host configurations service discovery object.
 	for userHost, hostConfig := range config.Hosts {
@@ -229,12 +398,45 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 		services.ForceHostServices(host, hostConfig.Services)
 	}
 
+	// Services set in the provider configuration take precedence over the
+	// CLI configuration and discovery.
+	discovery.apply(services, hostname)
+
 	// If a token wasn't set in the provider configuration block, try and fetch it
 	// from the environment or from Terraform's CLI configuration or configured credential helper.
+	var source tokenSource
+	var sourceID string
 	if token == "" {
-		if os.Getenv("TFE_TOKEN") != "" {
+		workloadIdentity, err := workloadIdentitySource(opts, &http.Client{Transport: transport})
+		if err != nil {
+			return nil, err
+		}
+
+		tokenFile := opts.TokenFile
+		if tokenFile == "" && os.Getenv("TFE_TOKEN") == "" {
+			tokenFile = os.Getenv("TFE_TOKEN_FILE")
+		}
+
+		switch {
+		case tokenFile != "":
+			log.Printf("[DEBUG] Reading token from %s", tokenFile)
+			fileSource, err := newFileTokenSource(tokenFile)
+			if err != nil {
+				return nil, err
+			}
+			token, _ = fileSource.Token()
+			source, sourceID = fileSource, "file:"+tokenFile
+		case os.Getenv("TFE_TOKEN") != "":
 			token = getTokenFromEnv()
-		} else {
+		case workloadIdentity != nil:
+			// The current token is part of the cache key, so a client configured
+			// after the token rotated doesn't reuse one holding a stale token.
+			token, err = workloadIdentity.Token()
+			if err != nil {
+				return nil, fmt.Errorf("failed to authenticate with workload identity: %w", err)
+			}
+			source, sourceID = workloadIdentity, "workload-identity"
+		default:
 			token = getTokenFromCreds(services, hostname)
 		}
 	}
@@ -244,11 +446,39 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 		return nil, ErrMissingAuthToken
 	}
 
+	// Tokens that change over the lifetime of the client are set on each
+	// request, so that they are refreshed before they expire.
+	var apiTransport http.RoundTripper = newMetricsTransport(logging.NewLoggingTransport("TFE", cassette))
+	if maxConcurrentRequests > 0 {
+		apiTransport = newConcurrencyTransport(concurrencyLimiter(hostname.String(), maxConcurrentRequests), apiTransport)
+	}
+	if source != nil {
+		apiTransport = newAuthTransport(source, apiTransport)
+	}
+	httpClient.Transport = newRetryTransport(retry, apiTransport)
+
+	if readCacheTTL > 0 {
+		log.Printf("[DEBUG] Caching API reads for %s", readCacheTTL)
+		httpClient.Transport = newReadCacheTransport(readCacheTTL, httpClient.Transport)
+	}
+	if readOnly {
+		log.Printf("[DEBUG] Rejecting every request other than GET as read_only is enabled")
+		httpClient.Transport = newReadOnlyTransport(httpClient.Transport)
+	}
+
 	return &ClientConfiguration{
-		Services:   services,
-		HTTPClient: httpClient,
-		TFEHost:    hostname,
-		Token:      token,
-		Insecure:   insecure,
+		Services:              services,
+		HTTPClient:            httpClient,
+		TFEHost:               hostname,
+		Token:                 token,
+		Insecure:              insecure,
+		Retry:                 retry,
+		ReadCacheTTL:          readCacheTTL,
+		MaxConcurrentRequests: maxConcurrentRequests,
+		TLS:                   tlsConfig,
+		Discovery:             discovery,
+		ReadOnly:              readOnly,
+
+		TokenSourceID: sourceID,
 	}, nil
 }
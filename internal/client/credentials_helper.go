@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	svchost "github.com/hashicorp/terraform-svchost"
+	"github.com/hashicorp/terraform-svchost/auth"
+)
+
+// credentialsHelperTimeout bounds how long a credentials helper may take to
+// return a token before we give up on it.
+const credentialsHelperTimeout = 30 * time.Second
+
+// ConfigCredentialsHelper is the structure of the "credentials_helper"
+// nested block within the CLI configuration.
+type ConfigCredentialsHelper struct {
+	Args []string `hcl:"args"`
+}
+
+// helperCredentialsSource is an auth.CredentialsSource that runs a Terraform
+// credentials helper program using the documented protocol: the program is
+// invoked with its configured arguments followed by "get" and the hostname,
+// and prints a JSON object such as {"token":"..."} to stdout.
+type helperCredentialsSource struct {
+	executable string
+	args       []string
+	timeout    time.Duration
+}
+
+// credentialsHelperSource returns a credentials source for the
+// credentials_helper block of the CLI configuration, or nil when there isn't
+// one or the helper program can't be found.
+func credentialsHelperSource(helpers map[string]*ConfigCredentialsHelper) auth.CredentialsSource {
+	if len(helpers) == 0 {
+		return nil
+	}
+	if len(helpers) > 1 {
+		// Terraform itself rejects a configuration like this one.
+		log.Printf("[WARN] Only one credentials_helper block may be configured, ignoring all of them")
+		return nil
+	}
+
+	for name, helper := range helpers {
+		executable, err := locateCredentialsHelper(name)
+		if err != nil {
+			log.Printf("[WARN] Unable to use credentials helper %q: %v", name, err)
+			return nil
+		}
+
+		var args []string
+		if helper != nil {
+			args = helper.Args
+		}
+
+		log.Printf("[DEBUG] Using credentials helper %s", executable)
+		return &helperCredentialsSource{
+			executable: executable,
+			args:       args,
+			timeout:    credentialsHelperTimeout,
+		}
+	}
+
+	return nil
+}
+
+// locateCredentialsHelper finds the terraform-credentials-<name> program in the
+// Terraform plugin directory, falling back to the PATH.
+func locateCredentialsHelper(name string) (string, error) {
+	filename := "terraform-credentials-" + name
+	if runtime.GOOS == "windows" {
+		filename += ".exe"
+	}
+
+	if dir, err := configDir(); err == nil {
+		candidate := filepath.Join(dir, "plugins", filename)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, nil
+		}
+	}
+
+	executable, err := exec.LookPath(filename)
+	if err != nil {
+		return "", fmt.Errorf("%s was not found in the Terraform plugin directory or in PATH", filename)
+	}
+
+	return filepath.Abs(executable)
+}
+
+func (s *helperCredentialsSource) ForHost(host svchost.Hostname) (auth.HostCredentials, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	args := append(append([]string{}, s.args...), "get", string(host))
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.executable, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Don't wait for grandchildren that still hold stdout open after the
+	// helper itself was killed.
+	cmd.WaitDelay = time.Second
+
+	err := cmd.Run()
+	if errText := strings.TrimSpace(stderr.String()); errText != "" && err == nil {
+		log.Printf("[DEBUG] Credentials helper %s wrote to stderr: %s", s.executable, errText)
+	}
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return nil, fmt.Errorf("credentials helper %s did not respond within %s", s.executable, s.timeout)
+	case err != nil:
+		errText := strings.TrimSpace(stderr.String())
+		if errText == "" {
+			errText = err.Error()
+		}
+		log.Printf("[WARN] Credentials helper %s failed for %s: %s", s.executable, host.ForDisplay(), errText)
+		return nil, fmt.Errorf("error in credentials helper %s: %s", s.executable, errText)
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(stdout.Bytes(), &m); err != nil {
+		return nil, fmt.Errorf("malformed output from credentials helper %s: %w", s.executable, err)
+	}
+
+	return auth.HostCredentialsFromMap(m), nil
+}
+
+func (s *helperCredentialsSource) StoreForHost(host svchost.Hostname, credentials auth.HostCredentialsWritable) error {
+	return errors.New("storing credentials with a credentials helper is not supported by the provider")
+}
+
+func (s *helperCredentialsSource) ForgetForHost(host svchost.Hostname) error {
+	return errors.New("forgetting credentials with a credentials helper is not supported by the provider")
+}
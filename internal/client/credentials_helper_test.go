@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	svchost "github.com/hashicorp/terraform-svchost"
+)
+
+// testCredentialsHelper installs a terraform-credentials-<name> shell script
+// with the given body into the plugin directory of a temporary HOME, and
+// returns the path of a CLI config file that configures it.
+func testCredentialsHelper(t *testing.T, name, body string) string {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("credentials helper tests use a shell script")
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	pluginDir := filepath.Join(home, ".terraform.d", "plugins")
+	if err := os.MkdirAll(pluginDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	script := filepath.Join(pluginDir, "terraform-credentials-"+name)
+	if err := os.WriteFile(script, []byte("#!/bin/sh\n"+body+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	rc := filepath.Join(home, ".terraformrc")
+	config := fmt.Sprintf(`
+credentials_helper %q {
+	args = ["--prefix", "test"]
+}`, name)
+	if err := os.WriteFile(rc, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TF_CLI_CONFIG_FILE", rc)
+
+	return rc
+}
+
+func TestCredentialsHelper_ForHost(t *testing.T) {
+	cases := map[string]struct {
+		body        string
+		expectToken string
+		expectErr   string
+	}{
+		"returns a token": {
+			// Echo the arguments back to make sure the protocol is followed.
+			body:        `echo "{\"token\": \"$1 $2 $3 $4\"}"`,
+			expectToken: "--prefix test get app.terraform.io",
+		},
+		"has no credentials": {
+			body: `echo "{}"`,
+		},
+		"fails with a message": {
+			body:      `echo "vault is sealed" >&2; exit 1`,
+			expectErr: "vault is sealed",
+		},
+		"malformed output": {
+			body:      `echo "not json"`,
+			expectErr: "malformed output",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			testCredentialsHelper(t, "test", tc.body)
+
+			source := credentialsSource(cliConfig())
+			creds, err := source.ForHost(svchost.Hostname("app.terraform.io"))
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			token := ""
+			if creds != nil {
+				token = creds.Token()
+			}
+			if token != tc.expectToken {
+				t.Fatalf("expected token %q, got %q", tc.expectToken, token)
+			}
+		})
+	}
+}
+
+func TestCredentialsHelper_timeout(t *testing.T) {
+	testCredentialsHelper(t, "slow", `sleep 5; echo "{}"`)
+
+	executable, err := locateCredentialsHelper("slow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source := &helperCredentialsSource{executable: executable, timeout: 100 * time.Millisecond}
+	_, err = source.ForHost(svchost.Hostname("app.terraform.io"))
+	if err == nil || !strings.Contains(err.Error(), "did not respond within") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestCredentialsHelper_staticCredentialsTakePrecedence(t *testing.T) {
+	rc := testCredentialsHelper(t, "test", `echo "{\"token\": \"from-helper\"}"`)
+
+	f, err := os.OpenFile(rc, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprint(f, `
+credentials "app.terraform.io" {
+	token = "from-config"
+}`)
+	f.Close()
+
+	source := credentialsSource(cliConfig())
+
+	creds, err := source.ForHost(svchost.Hostname("app.terraform.io"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Token() != "from-config" {
+		t.Fatalf("expected static credentials to be used, got %q", creds.Token())
+	}
+
+	creds, err = source.ForHost(svchost.Hostname("tfe.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Token() != "from-helper" {
+		t.Fatalf("expected helper credentials to be used, got %q", creds.Token())
+	}
+}
+
+func Test_GetClientWithCredentialsHelper(t *testing.T) {
+	srv := testServer(t)
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	testCredentialsHelper(t, "test", fmt.Sprintf(`echo '{"token": "%s"}'`, testToken))
+	t.Setenv("TFE_TOKEN", "")
+
+	client, err := GetClient(&ClientOptions{Hostname: serverURL.Host, SSLSkipVerify: true})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting client: %q", err)
+	}
+
+	_, err = client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{})
+	if err != nil {
+		t.Errorf("Unexpected error from using client: %q", err)
+	}
+}
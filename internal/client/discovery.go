@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	svchost "github.com/hashicorp/terraform-svchost"
+	"github.com/hashicorp/terraform-svchost/disco"
+)
+
+// DiscoveryConfig replaces service discovery for the configured host, for
+// installs where /.well-known/terraform.json can't be reached.
+type DiscoveryConfig struct {
+	// APIURL is the base URL of the API, such as https://tfe.example.com/api/v2/.
+	APIURL string
+
+	// ServiceOverrides maps service IDs, such as "tfe.v2.2", to their URL.
+	ServiceOverrides map[string]string
+
+	// SkipDiscovery skips service discovery and the check of the tfe-provider
+	// version constraints published by the host.
+	SkipDiscovery bool
+}
+
+// resolveDiscoveryConfig merges the provider-level discovery settings with the
+// TFE_API_URL and TFE_SKIP_DISCOVERY environment variables.
+func resolveDiscoveryConfig(opts *ClientOptions) (DiscoveryConfig, error) {
+	config := DiscoveryConfig{
+		APIURL:           opts.APIURL,
+		ServiceOverrides: opts.ServiceOverrides,
+	}
+
+	if config.APIURL == "" {
+		config.APIURL = os.Getenv("TFE_API_URL")
+	}
+	if config.APIURL != "" {
+		u, err := url.Parse(config.APIURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return config, fmt.Errorf("api_url must be an absolute URL, got %q", config.APIURL)
+		}
+	}
+
+	switch {
+	case opts.SkipDiscovery != nil:
+		config.SkipDiscovery = *opts.SkipDiscovery
+	case os.Getenv("TFE_SKIP_DISCOVERY") != "":
+		v := os.Getenv("TFE_SKIP_DISCOVERY")
+		skip, err := strconv.ParseBool(v)
+		if err != nil {
+			return config, fmt.Errorf("TFE_SKIP_DISCOVERY has unrecognized value %q", v)
+		}
+		config.SkipDiscovery = skip
+	}
+
+	return config, nil
+}
+
+// bypassed reports whether service discovery is replaced by the provider
+// configuration.
+func (c DiscoveryConfig) bypassed() bool {
+	return c.SkipDiscovery || c.APIURL != "" || len(c.ServiceOverrides) > 0
+}
+
+// services returns the services to use for hostname instead of discovering
+// them.
+func (c DiscoveryConfig) services(hostname svchost.Hostname) map[string]interface{} {
+	services := map[string]interface{}{}
+
+	apiURL := c.APIURL
+	if apiURL == "" && c.SkipDiscovery {
+		apiURL = fmt.Sprintf("https://%s/api/v2/", hostname)
+	}
+	if apiURL != "" {
+		services["tfe.v2"] = apiURL
+		for _, id := range tfeServiceIDs {
+			services[id] = apiURL
+		}
+	}
+
+	for id, u := range c.ServiceOverrides {
+		services[id] = u
+	}
+
+	return services
+}
+
+// apply forces the services of hostname when discovery is bypassed.
+func (c DiscoveryConfig) apply(services *disco.Disco, hostname svchost.Hostname) {
+	if !c.bypassed() {
+		return
+	}
+
+	log.Printf("[WARN] %s", c.describe(hostname))
+	services.ForceHostServices(hostname, c.services(hostname))
+}
+
+// describe explains what was bypassed, for the diagnostic shown to users.
+func (c DiscoveryConfig) describe(hostname svchost.Hostname) string {
+	services := c.services(hostname)
+	ids := make([]string, 0, len(services))
+	for id := range services {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var lines []string
+	for _, id := range ids {
+		lines = append(lines, fmt.Sprintf("  %s = %s", id, services[id]))
+	}
+
+	msg := fmt.Sprintf("Service discovery for %s was replaced by the provider configuration, "+
+		"so %s/.well-known/terraform.json was not requested. Using these services:\n%s",
+		hostname.ForDisplay(), hostname.ForDisplay(), strings.Join(lines, "\n"))
+	if c.SkipDiscovery {
+		msg += "\n\nThe tfe-provider version constraints published by the host were not checked, " +
+			"so this version of the provider may not be compatible with it."
+	}
+
+	return msg
+}
+
+// DiscoveryWarning returns an explanation of what was bypassed when the
+// provider configuration replaces service discovery, or an empty string when it
+// doesn't.
+func DiscoveryWarning(opts *ClientOptions) string {
+	config, err := resolveDiscoveryConfig(opts)
+	if err != nil || !config.bypassed() {
+		return ""
+	}
+
+	hostname, err := resolveHostname(opts.Hostname)
+	if err != nil {
+		return ""
+	}
+
+	return config.describe(hostname)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+	svchost "github.com/hashicorp/terraform-svchost"
+)
+
+func TestResolveDiscoveryConfig(t *testing.T) {
+	t.Setenv("TFE_API_URL", "")
+	t.Setenv("TFE_SKIP_DISCOVERY", "")
+
+	config, err := resolveDiscoveryConfig(&ClientOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.bypassed() {
+		t.Fatalf("expected discovery not to be bypassed by default, got %+v", config)
+	}
+
+	t.Setenv("TFE_API_URL", "https://tfe.example.com/api/v2/")
+	t.Setenv("TFE_SKIP_DISCOVERY", "true")
+	config, err = resolveDiscoveryConfig(&ClientOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.APIURL != "https://tfe.example.com/api/v2/" || !config.SkipDiscovery {
+		t.Fatalf("expected the env vars to be used, got %+v", config)
+	}
+
+	skip := false
+	config, err = resolveDiscoveryConfig(&ClientOptions{APIURL: "https://other.example.com/api/v2/", SkipDiscovery: &skip})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.APIURL != "https://other.example.com/api/v2/" || config.SkipDiscovery {
+		t.Fatalf("expected the provider configuration to take precedence, got %+v", config)
+	}
+
+	if _, err := resolveDiscoveryConfig(&ClientOptions{APIURL: "tfe.example.com/api/v2/"}); err == nil {
+		t.Fatal("expected an error for a relative api_url")
+	}
+
+	t.Setenv("TFE_SKIP_DISCOVERY", "sometimes")
+	if _, err := resolveDiscoveryConfig(&ClientOptions{}); err == nil {
+		t.Fatal("expected an error for an unrecognized TFE_SKIP_DISCOVERY")
+	}
+}
+
+func TestDiscoveryConfig_services(t *testing.T) {
+	hostname := svchost.Hostname("tfe.example.com")
+
+	services := DiscoveryConfig{SkipDiscovery: true}.services(hostname)
+	for _, id := range append([]string{"tfe.v2"}, tfeServiceIDs...) {
+		if services[id] != "https://tfe.example.com/api/v2/" {
+			t.Fatalf("expected %s to default to the API on the host, got %v", id, services[id])
+		}
+	}
+
+	services = DiscoveryConfig{
+		APIURL:           "https://api.example.com/tfe/api/v2/",
+		ServiceOverrides: map[string]string{"tfe.v2.2": "https://other.example.com/api/v2/", "modules.v1": "https://registry.example.com/v1/modules/"},
+	}.services(hostname)
+	if services["tfe.v2"] != "https://api.example.com/tfe/api/v2/" {
+		t.Fatalf("expected api_url to be used, got %v", services["tfe.v2"])
+	}
+	if services["tfe.v2.2"] != "https://other.example.com/api/v2/" || services["modules.v1"] != "https://registry.example.com/v1/modules/" {
+		t.Fatalf("expected the service overrides to be used, got %v", services)
+	}
+
+	warning := DiscoveryConfig{SkipDiscovery: true}.describe(hostname)
+	if !strings.Contains(warning, "tfe.v2.2 = https://tfe.example.com/api/v2/") || !strings.Contains(warning, "version constraints") {
+		t.Fatalf("expected the warning to list the services and the skipped check, got %q", warning)
+	}
+}
+
+func TestGetClient_bypassesDiscovery(t *testing.T) {
+	mux := http.NewServeMux()
+	for route, handler := range testDefaultRequestHandlers {
+		if route == "/.well-known/terraform.json" {
+			handler = func(w http.ResponseWriter, _ *http.Request) {
+				t.Error("expected service discovery to be skipped")
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}
+		mux.HandleFunc(route, handler)
+	}
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+	t.Setenv("TFE_API_URL", "")
+	t.Setenv("TFE_SKIP_DISCOVERY", "")
+
+	skip := true
+	cases := map[string]*ClientOptions{
+		"skip_discovery": {SkipDiscovery: &skip},
+		"api_url":        {APIURL: srv.URL + "/api/v2/"},
+	}
+
+	for name, opts := range cases {
+		opts.Hostname = serverURL.Host
+		opts.Token = testToken
+		opts.SSLSkipVerify = true
+
+		client, err := GetClient(opts)
+		if err != nil {
+			t.Fatalf("%s: unexpected error when getting client: %v", name, err)
+		}
+
+		_, err = client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{})
+		if err != nil {
+			t.Errorf("%s: unexpected error from using client: %v", name, err)
+		}
+
+		if DiscoveryWarning(opts) == "" {
+			t.Errorf("%s: expected a warning about the bypassed discovery", name)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/terraform-svchost/disco"
+)
+
+// doctorTLSTimeout is how long the TLS handshake with the host may take.
+const doctorTLSTimeout = 10 * time.Second
+
+// certificateExpiryWarning is how long before it expires the certificate of
+// the host is reported.
+const certificateExpiryWarning = 30 * 24 * time.Hour
+
+// DoctorStatus is the outcome of a step of Diagnose.
+type DoctorStatus string
+
+const (
+	DoctorOK      DoctorStatus = "ok"
+	DoctorWarning DoctorStatus = "warning"
+	DoctorError   DoctorStatus = "error"
+	DoctorSkipped DoctorStatus = "skipped"
+)
+
+// DoctorStep is one of the checks made by Diagnose.
+type DoctorStep struct {
+	Name    string            `json:"name"`
+	Status  DoctorStatus      `json:"status"`
+	Summary string            `json:"summary"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// DoctorReport is the outcome of Diagnose. It never contains a token.
+type DoctorReport struct {
+	Hostname string        `json:"hostname"`
+	Steps    []*DoctorStep `json:"steps"`
+}
+
+// Failed reports whether any step failed.
+func (r *DoctorReport) Failed() bool {
+	for _, step := range r.Steps {
+		if step.Status == DoctorError {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteText writes the report in a human-readable form.
+func (r *DoctorReport) WriteText(w io.Writer) {
+	fmt.Fprintf(w, "Checking the connection to %s\n\n", r.Hostname)
+
+	for _, step := range r.Steps {
+		fmt.Fprintf(w, "[%s] %s: %s\n", strings.ToUpper(string(step.Status)), step.Name, step.Summary)
+
+		keys := make([]string, 0, len(step.Details))
+		for k := range step.Details {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(w, "    %s: %s\n", k, step.Details[k])
+		}
+	}
+}
+
+// WriteJSON writes the report as JSON.
+func (r *DoctorReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+func (r *DoctorReport) add(name string, status DoctorStatus, summary string, details map[string]string) *DoctorStep {
+	step := &DoctorStep{Name: name, Status: status, Summary: summary, Details: details}
+	r.Steps = append(r.Steps, step)
+	return step
+}
+
+// Diagnose checks step by step that a client can be configured with opts,
+// the way the provider would configure it, and that it can connect to and
+// authenticate with the host. Once a step fails, the steps depending on it
+// are skipped. When organization is set, its entitlements are read too.
+func Diagnose(ctx context.Context, opts *ClientOptions, organization string) *DoctorReport {
+	report := &DoctorReport{Hostname: opts.Hostname}
+
+	hostname, err := resolveHostname(opts.Hostname)
+	if err != nil {
+		report.add("Hostname", DoctorError, err.Error(), nil)
+		return report
+	}
+	report.Hostname = hostname.ForDisplay()
+
+	diagnoseCLIConfig(report, hostname.String())
+
+	config, err := configure(opts)
+	if err != nil {
+		report.add("Token", DoctorError, err.Error(), nil)
+		report.skip("Service discovery", "Version constraints", "TLS", "Identity", "Organization")
+		return report
+	}
+	report.add("Token", DoctorOK, "Found a token in "+tokenSourceDescription(opts, config), map[string]string{
+		"fingerprint": fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(config.Token)))[:15],
+	})
+
+	host, apiURL := diagnoseDiscovery(report, config)
+	diagnoseConstraints(report, config, host)
+	diagnoseTLS(report, config, apiURL)
+
+	if apiURL == nil {
+		report.skip("Identity", "Organization")
+		return report
+	}
+	tfeClient, err := GetClient(opts)
+	if err != nil {
+		report.add("Identity", DoctorError, "Failed to create a client: "+err.Error(), nil)
+		report.skip("Organization")
+		return report
+	}
+	if !diagnoseIdentity(ctx, report, tfeClient) {
+		report.skip("Organization")
+		return report
+	}
+	diagnoseOrganization(ctx, report, tfeClient, organization)
+
+	return report
+}
+
+func (r *DoctorReport) skip(names ...string) {
+	for _, name := range names {
+		r.add(name, DoctorSkipped, "Skipped as a previous step failed", nil)
+	}
+}
+
+// diagnoseCLIConfig reports the Terraform CLI configuration files, and what
+// they configure for the host. The provider ignores files it can't read.
+func diagnoseCLIConfig(report *DoctorReport, hostname string) {
+	status := DoctorOK
+	details := map[string]string{}
+	var configs []CLIHostConfig
+
+	files := map[string]string{"config_file": locateConfigFile()}
+	if path, err := credentialsFile(); err == nil {
+		files["credentials_file"] = path
+	}
+	for name, path := range files {
+		if path == "" {
+			continue
+		}
+
+		content, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			details[name] = path + " (not found)"
+			continue
+		case err != nil:
+			status, details[name] = DoctorWarning, fmt.Sprintf("%s (unreadable: %v)", path, err)
+			continue
+		}
+
+		var config CLIHostConfig
+		obj, err := hcl.Parse(string(content))
+		if err == nil {
+			err = hcl.DecodeObject(&config, obj)
+		}
+		if err != nil {
+			status, details[name] = DoctorWarning, fmt.Sprintf("%s (invalid: %v)", path, err)
+			continue
+		}
+		details[name] = path
+		configs = append(configs, config)
+	}
+
+	for _, config := range configs {
+		if _, ok := config.Credentials[hostname]; ok {
+			details["credentials"] = "found for " + hostname
+		}
+		if _, ok := config.Hosts[hostname]; ok {
+			details["host_block"] = "found for " + hostname
+		}
+		for name := range config.CredentialsHelpers {
+			details["credentials_helper"] = name
+		}
+	}
+
+	summary := "Read the Terraform CLI configuration"
+	if status != DoctorOK {
+		summary = "Some Terraform CLI configuration files are ignored"
+	}
+	report.add("CLI configuration", status, summary, details)
+}
+
+// tokenSourceDescription explains where the token of config comes from,
+// following the precedence of configure.
+func tokenSourceDescription(opts *ClientOptions, config *ClientConfiguration) string {
+	switch {
+	case strings.HasPrefix(config.TokenSourceID, "file:"):
+		return "the token file " + strings.TrimPrefix(config.TokenSourceID, "file:")
+	case config.TokenSourceID == "workload-identity":
+		return "a workload identity token"
+	case opts.Token != "":
+		return "the token argument"
+	case os.Getenv("TFE_TOKEN") != "":
+		return "TFE_TOKEN"
+	default:
+		return "the Terraform CLI credentials for the host"
+	}
+}
+
+// diagnoseDiscovery reports the services of the host, and returns the host
+// and the URL of the API, which are nil when discovery failed.
+func diagnoseDiscovery(report *DoctorReport, config *ClientConfiguration) (*disco.Host, *url.URL) {
+	host, err := config.Services.Discover(config.TFEHost)
+	if err != nil {
+		report.add("Service discovery", DoctorError, err.Error(), nil)
+		return nil, nil
+	}
+
+	for _, id := range tfeServiceIDs {
+		apiURL, err := host.ServiceURL(id)
+		if err != nil {
+			continue
+		}
+
+		step := report.add("Service discovery", DoctorOK, "Found the API", map[string]string{
+			"service": id,
+			"api_url": apiURL.String(),
+		})
+		if config.Discovery.bypassed() {
+			step.Status, step.Summary = DoctorWarning, config.Discovery.describe(config.TFEHost)
+		}
+		return host, apiURL
+	}
+
+	report.add("Service discovery", DoctorError, fmt.Sprintf("%s doesn't provide any of the services %s", config.TFEHost.ForDisplay(), strings.Join(tfeServiceIDs, ", ")), nil)
+	return nil, nil
+}
+
+// diagnoseConstraints reports whether this version of the provider satisfies
+// the version constraints published by the host.
+func diagnoseConstraints(report *DoctorReport, config *ClientConfiguration, host *disco.Host) {
+	const name = "Version constraints"
+
+	switch {
+	case config.Discovery.SkipDiscovery:
+		report.add(name, DoctorWarning, "Not checked, as service discovery is skipped", nil)
+		return
+	case host == nil:
+		report.skip(name)
+		return
+	}
+
+	constraints, err := host.VersionConstraints(tfeServiceIDs[0], "tfe-provider")
+	if err != nil || constraints == nil {
+		report.add(name, DoctorOK, "The host doesn't publish version constraints for this provider", nil)
+		return
+	}
+
+	details := map[string]string{
+		"minimum": constraints.Minimum,
+		"maximum": constraints.Maximum,
+	}
+	if err := CheckConstraints(constraints); err != nil {
+		report.add(name, DoctorError, err.Error(), details)
+		return
+	}
+	report.add(name, DoctorOK, "This version of the provider is compatible with the host", details)
+}
+
+// diagnoseTLS connects to the API, or to the host when the API wasn't found,
+// with the TLS settings of the client, and reports its certificate.
+func diagnoseTLS(report *DoctorReport, config *ClientConfiguration, apiURL *url.URL) {
+	const name = "TLS"
+
+	address := config.TFEHost.String()
+	if apiURL != nil {
+		if apiURL.Scheme != "https" {
+			report.add(name, DoctorWarning, "The API doesn't use HTTPS", map[string]string{"api_url": apiURL.String()})
+			return
+		}
+		address = apiURL.Host
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "443")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: config.Insecure}
+	if err := config.TLS.apply(tlsConfig); err != nil {
+		report.add(name, DoctorError, err.Error(), nil)
+		return
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: doctorTLSTimeout}, "tcp", address, tlsConfig)
+	if err != nil {
+		report.add(name, DoctorError, fmt.Sprintf("Failed to connect to %s: %v", address, err), nil)
+		return
+	}
+	defer conn.Close()
+
+	state := conn.ConnectionState()
+	details := map[string]string{
+		"address": address,
+		"version": tls.VersionName(state.Version),
+	}
+	if len(config.TLS.ClientCert) > 0 {
+		details["client_certificate"] = "presented"
+	}
+
+	status, summary := DoctorOK, "Verified the certificate of the host"
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		details["subject"] = cert.Subject.String()
+		details["issuer"] = cert.Issuer.String()
+		details["expires"] = cert.NotAfter.UTC().Format(time.RFC3339)
+		if time.Until(cert.NotAfter) < certificateExpiryWarning {
+			status, summary = DoctorWarning, "The certificate of the host expires soon"
+		}
+	}
+	if config.Insecure {
+		status, summary = DoctorWarning, "Connected, but certificate verification is disabled by ssl_skip_verify"
+	}
+
+	report.add(name, status, summary, details)
+}
+
+// diagnoseIdentity reports who the token authenticates as, and whether it
+// was accepted.
+func diagnoseIdentity(ctx context.Context, report *DoctorReport, tfeClient *tfe.Client) bool {
+	user, err := tfeClient.Users.ReadCurrent(ctx)
+	if err != nil {
+		report.add("Identity", DoctorError, "The token was rejected: "+err.Error(), nil)
+		return false
+	}
+
+	details := map[string]string{
+		"id":       user.ID,
+		"username": user.Username,
+	}
+	if version := tfeClient.RemoteAPIVersion(); version != "" {
+		details["api_version"] = version
+	}
+	report.add("Identity", DoctorOK, "Authenticated as "+user.Username, details)
+	return true
+}
+
+// diagnoseOrganization reports the entitlements of the organization.
+func diagnoseOrganization(ctx context.Context, report *DoctorReport, tfeClient *tfe.Client, organization string) {
+	const name = "Organization"
+
+	if organization == "" {
+		report.add(name, DoctorSkipped, "No organization was given", nil)
+		return
+	}
+
+	entitlements, err := tfeClient.Organizations.ReadEntitlements(ctx, organization)
+	if err != nil {
+		report.add(name, DoctorError, fmt.Sprintf("Failed to read the entitlements of %s: %v", organization, err), nil)
+		return
+	}
+
+	var enabled, disabled []string
+	v := reflect.ValueOf(*entitlements)
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Type.Kind() != reflect.Bool {
+			continue
+		}
+		entitlement := strings.TrimPrefix(field.Tag.Get("jsonapi"), "attr,")
+		if v.Field(i).Bool() {
+			enabled = append(enabled, entitlement)
+		} else {
+			disabled = append(disabled, entitlement)
+		}
+	}
+
+	report.add(name, DoctorOK, "Read the entitlements of "+organization, map[string]string{
+		"enabled":  strings.Join(enabled, ", "),
+		"disabled": strings.Join(disabled, ", "),
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testDoctorServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for route, handler := range testDefaultRequestHandlers {
+		mux.HandleFunc(route, handler)
+	}
+	mux.HandleFunc("/api/v2/account/details", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.Header.Get("Authorization") != fmt.Sprintf("Bearer %s", testToken) {
+			w.WriteHeader(http.StatusUnauthorized)
+			io.WriteString(w, `{"errors": [{"status": "401", "title": "unauthorized"}]}`)
+			return
+		}
+		io.WriteString(w, `{"data": {"id": "user-123", "type": "users", "attributes": {"username": "admin"}}}`)
+	})
+	mux.HandleFunc("/api/v2/organizations/hashicorp/entitlement-set", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		io.WriteString(w, `{"data": {"id": "org-123", "type": "entitlement-sets", "attributes": {"agents": true, "sso": false}}}`)
+	})
+
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDiagnose(t *testing.T) {
+	srv := testDoctorServer(t)
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("TF_CLI_CONFIG_FILE", filepath.Join(dir, "missing.tfrc"))
+	t.Setenv("TFE_TOKEN", "")
+
+	cases := map[string]struct {
+		token        string
+		organization string
+		statuses     map[string]DoctorStatus
+		failed       bool
+	}{
+		"valid token": {
+			token:        testToken,
+			organization: "hashicorp",
+			statuses: map[string]DoctorStatus{
+				"CLI configuration":   DoctorOK,
+				"Token":               DoctorOK,
+				"Service discovery":   DoctorOK,
+				"Version constraints": DoctorOK,
+				"TLS":                 DoctorWarning,
+				"Identity":            DoctorOK,
+				"Organization":        DoctorOK,
+			},
+		},
+		"rejected token": {
+			token: "invalid",
+			statuses: map[string]DoctorStatus{
+				"Token":        DoctorOK,
+				"Identity":     DoctorError,
+				"Organization": DoctorSkipped,
+			},
+			failed: true,
+		},
+		"missing token": {
+			statuses: map[string]DoctorStatus{
+				"Token":        DoctorError,
+				"Identity":     DoctorSkipped,
+				"Organization": DoctorSkipped,
+			},
+			failed: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			report := Diagnose(context.Background(), &ClientOptions{
+				Hostname:      serverURL.Host,
+				Token:         tc.token,
+				SSLSkipVerify: true,
+			}, tc.organization)
+
+			if report.Failed() != tc.failed {
+				t.Errorf("Expected failed to be %t, got %t", tc.failed, report.Failed())
+			}
+
+			statuses := map[string]DoctorStatus{}
+			for _, step := range report.Steps {
+				statuses[step.Name] = step.Status
+			}
+			for name, expected := range tc.statuses {
+				if statuses[name] != expected {
+					t.Errorf("Expected step %q to be %q, got %q", name, expected, statuses[name])
+				}
+			}
+
+			var text, js bytes.Buffer
+			report.WriteText(&text)
+			if err := report.WriteJSON(&js); err != nil {
+				t.Fatalf("Unexpected error writing JSON: %v", err)
+			}
+			if tc.token != "" && (strings.Contains(text.String(), tc.token) || strings.Contains(js.String(), tc.token)) {
+				t.Fatalf("Expected the report to never contain the token:\n%s", text.String())
+			}
+
+			var decoded DoctorReport
+			if err := json.Unmarshal(js.Bytes(), &decoded); err != nil {
+				t.Fatalf("Unexpected error decoding JSON: %v", err)
+			}
+			if len(decoded.Steps) != len(report.Steps) {
+				t.Fatalf("Expected %d steps in the JSON report, got %d", len(report.Steps), len(decoded.Steps))
+			}
+		})
+	}
+}
@@ -13,7 +13,8 @@ var clientCache *ClientConfigMap
 
 func init() {
 	clientCache = &ClientConfigMap{
-		values: make(map[string]*tfe.Client),
-		mu:     sync.Mutex{},
+		values:  make(map[string]*tfe.Client),
+		sources: make(map[string]string),
+		mu:      sync.Mutex{},
 	}
 }
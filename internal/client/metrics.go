@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-tfe/internal/logging"
+)
+
+// EnvAPIMetricsPath is the path to write the API usage summary to as JSON.
+const EnvAPIMetricsPath = "TFE_API_METRICS_PATH"
+
+var (
+	apiPathPrefix = regexp.MustCompile(`^/api/v2`)
+
+	// apiIDPattern matches the IDs of API resources, such as ws-4j8p6jX1w33MiDC7.
+	// The random part must contain a digit or an uppercase letter, so that
+	// paths like notification-configurations aren't taken for IDs.
+	apiIDPattern = regexp.MustCompile(`^[a-z]+(-[a-z]+)*-[A-Za-z0-9]{12,}$`)
+	apiIDRandom  = regexp.MustCompile(`-[A-Za-z0-9]*[A-Z0-9][A-Za-z0-9]*$`)
+)
+
+// EndpointMetrics is the API usage of a single endpoint.
+type EndpointMetrics struct {
+	Endpoint  string `json:"endpoint"`
+	Requests  int    `json:"requests"`
+	Errors    int    `json:"errors"`
+	Retries   int    `json:"retries"`
+	LatencyMS int64  `json:"latency_ms"`
+}
+
+// APIMetricsSummary is the API usage of every endpoint called by the provider.
+type APIMetricsSummary struct {
+	Requests  int               `json:"requests"`
+	Errors    int               `json:"errors"`
+	Retries   int               `json:"retries"`
+	LatencyMS int64             `json:"latency_ms"`
+	Endpoints []EndpointMetrics `json:"endpoints"`
+}
+
+// apiMetrics counts the requests made by every client, so a single summary can
+// be reported for the whole run.
+type apiMetrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*EndpointMetrics
+	latency   map[string]time.Duration
+	// reported is the number of requests at the time of the last report, so
+	// the summary isn't reported again when nothing changed.
+	reported int
+}
+
+var metrics = newAPIMetrics()
+
+func newAPIMetrics() *apiMetrics {
+	return &apiMetrics{
+		endpoints: make(map[string]*EndpointMetrics),
+		latency:   make(map[string]time.Duration),
+	}
+}
+
+func (m *apiMetrics) record(endpoint string, failed, retry bool, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.endpoints[endpoint]
+	if !ok {
+		e = &EndpointMetrics{Endpoint: endpoint}
+		m.endpoints[endpoint] = e
+	}
+
+	e.Requests++
+	if failed {
+		e.Errors++
+	}
+	if retry {
+		e.Retries++
+	}
+	m.latency[endpoint] += latency
+	e.LatencyMS = m.latency[endpoint].Milliseconds()
+}
+
+// summary returns the usage so far, with the busiest endpoints first.
+func (m *apiMetrics) summary() APIMetricsSummary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	summary := APIMetricsSummary{Endpoints: make([]EndpointMetrics, 0, len(m.endpoints))}
+	var latency time.Duration
+	for endpoint, e := range m.endpoints {
+		summary.Endpoints = append(summary.Endpoints, *e)
+		summary.Requests += e.Requests
+		summary.Errors += e.Errors
+		summary.Retries += e.Retries
+		latency += m.latency[endpoint]
+	}
+	summary.LatencyMS = latency.Milliseconds()
+
+	sort.Slice(summary.Endpoints, func(i, j int) bool {
+		a, b := summary.Endpoints[i], summary.Endpoints[j]
+		if a.Requests != b.Requests {
+			return a.Requests > b.Requests
+		}
+		return a.Endpoint < b.Endpoint
+	})
+
+	return summary
+}
+
+// ReportAPIMetrics logs a summary of the API requests made so far, and writes
+// it as JSON to the path set in TFE_API_METRICS_PATH. It is called when the
+// provider is stopped and when the process exits, and does nothing if no
+// requests were made since the last report.
+func ReportAPIMetrics() {
+	summary := metrics.summary()
+
+	metrics.mu.Lock()
+	if summary.Requests == metrics.reported {
+		metrics.mu.Unlock()
+		return
+	}
+	metrics.reported = summary.Requests
+	metrics.mu.Unlock()
+
+	log.Printf("[INFO] TFE API usage summary:\n%s", formatAPIMetrics(summary))
+
+	path := os.Getenv(EnvAPIMetricsPath)
+	if path == "" {
+		return
+	}
+
+	b, err := json.MarshalIndent(summary, "", "  ")
+	if err == nil {
+		err = os.WriteFile(path, b, 0o644)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to write the API usage summary to %s: %v", path, err)
+	}
+}
+
+func formatAPIMetrics(summary APIMetricsSummary) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ENDPOINT\tREQUESTS\tERRORS\tRETRIES\tLATENCY")
+	for _, e := range summary.Endpoints {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\n", e.Endpoint, e.Requests, e.Errors, e.Retries, time.Duration(e.LatencyMS)*time.Millisecond)
+	}
+	fmt.Fprintf(w, "TOTAL\t%d\t%d\t%d\t%s\n", summary.Requests, summary.Errors, summary.Retries, time.Duration(summary.LatencyMS)*time.Millisecond)
+	w.Flush()
+
+	return strings.TrimSpace(buf.String())
+}
+
+// endpointTemplate returns the endpoint a request was made to, with the IDs and
+// names in its path replaced by placeholders, such as
+// "GET /organizations/{org}/workspaces/{name}".
+func endpointTemplate(method, path string) string {
+	segments := strings.Split(strings.Trim(apiPathPrefix.ReplaceAllString(path, ""), "/"), "/")
+
+	for i, segment := range segments {
+		var previous string
+		if i > 0 {
+			previous = segments[i-1]
+		}
+
+		switch {
+		case previous == "organizations":
+			segments[i] = "{org}"
+		case previous == "workspaces" && i > 1 && segments[i-2] == "{org}":
+			segments[i] = "{name}"
+		case apiIDPattern.MatchString(segment) && apiIDRandom.MatchString(segment):
+			segments[i] = "{id}"
+		}
+	}
+
+	return method + " /" + strings.Join(segments, "/")
+}
+
+// metricsTransport records the API usage of every request. It sits below the
+// retry transport, so every attempt is counted.
+type metricsTransport struct {
+	delegate http.RoundTripper
+}
+
+func newMetricsTransport(delegate http.RoundTripper) *metricsTransport {
+	return &metricsTransport{delegate: delegate}
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.delegate.RoundTrip(req)
+
+	failed := err != nil || resp.StatusCode >= http.StatusBadRequest
+	retry := logging.RetryAttempt(req.Context()) > 0
+	metrics.record(endpointTemplate(req.Method, req.URL.Path), failed, retry, time.Since(start))
+
+	return resp, err
+}
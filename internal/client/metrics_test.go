@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/internal/logging"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	cases := map[string]string{
+		"/api/v2/organizations/hashicorp/workspaces":                                  "GET /organizations/{org}/workspaces",
+		"/api/v2/organizations/hashicorp/workspaces/my-workspace":                     "GET /organizations/{org}/workspaces/{name}",
+		"/api/v2/workspaces/ws-4j8p6jX1w33MiDC7":                                      "GET /workspaces/{id}",
+		"/api/v2/workspaces/ws-4j8p6jX1w33MiDC7/notification-configurations":          "GET /workspaces/{id}/notification-configurations",
+		"/api/v2/workspaces/ws-4j8p6jX1w33MiDC7/relationships/remote-state-consumers": "GET /workspaces/{id}/relationships/remote-state-consumers",
+		"/api/v2/runs/run-CZcmD7eagjhyX0vN/apply":                                     "GET /runs/{id}/apply",
+		"/.well-known/terraform.json":                                                 "GET /.well-known/terraform.json",
+	}
+
+	for path, expected := range cases {
+		if got := endpointTemplate("GET", path); got != expected {
+			t.Errorf("%s: expected %q, got %q", path, expected, got)
+		}
+	}
+}
+
+func TestMetricsTransport(t *testing.T) {
+	previous := metrics
+	metrics = newAPIMetrics()
+	t.Cleanup(func() { metrics = previous })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/workspaces/ws-4j8p6jX1w33MiDC7" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	transport := newMetricsTransport(http.DefaultTransport)
+	do := func(path string, attempt int) {
+		req, _ := http.NewRequest("GET", srv.URL+path, nil)
+		req = req.WithContext(logging.WithRetryAttempt(req.Context(), attempt))
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	do("/api/v2/organizations/foo/workspaces", 0)
+	do("/api/v2/organizations/bar/workspaces", 0)
+	do("/api/v2/organizations/bar/workspaces", 1)
+	do("/api/v2/workspaces/ws-4j8p6jX1w33MiDC7", 0)
+
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	t.Setenv(EnvAPIMetricsPath, path)
+	ReportAPIMetrics()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var summary APIMetricsSummary
+	if err := json.Unmarshal(b, &summary); err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.Requests != 4 || summary.Errors != 1 || summary.Retries != 1 {
+		t.Fatalf("unexpected totals: %+v", summary)
+	}
+	if len(summary.Endpoints) != 2 {
+		t.Fatalf("expected 2 endpoints, got %+v", summary.Endpoints)
+	}
+	if e := summary.Endpoints[0]; e.Endpoint != "GET /organizations/{org}/workspaces" || e.Requests != 3 || e.Retries != 1 || e.Errors != 0 {
+		t.Fatalf("unexpected metrics for the busiest endpoint: %+v", e)
+	}
+	if e := summary.Endpoints[1]; e.Endpoint != "GET /workspaces/{id}" || e.Requests != 1 || e.Errors != 1 {
+		t.Fatalf("unexpected metrics for the failed endpoint: %+v", e)
+	}
+
+	// Nothing is reported again until more requests are made.
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	ReportAPIMetrics()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected the summary not to be written again, got %v", err)
+	}
+}
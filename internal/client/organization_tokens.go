@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// GetOrganizationClients configures a client for each organization in tokens,
+// which maps an organization name to a token, and in tokenFiles, which maps an
+// organization name to the path of a file containing one. Every other setting
+// is taken from opts, and none of the tokens fall back to the environment or
+// the Terraform CLI configuration.
+func GetOrganizationClients(opts *ClientOptions, tokens, tokenFiles map[string]string) (map[string]*tfe.Client, error) {
+	clients := make(map[string]*tfe.Client, len(tokens)+len(tokenFiles))
+	for organization, token := range tokens {
+		if _, ok := tokenFiles[organization]; ok {
+			return nil, fmt.Errorf("organization %q is set in both organization_tokens and organization_token_files", organization)
+		}
+		if strings.TrimSpace(token) == "" {
+			return nil, fmt.Errorf("the token for organization %q in organization_tokens is empty", organization)
+		}
+
+		orgOpts := *opts
+		orgOpts.Token, orgOpts.TokenFile = token, ""
+		client, err := GetClient(&orgOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure the client for organization %q: %w", organization, err)
+		}
+		clients[organization] = client
+	}
+
+	for organization, tokenFile := range tokenFiles {
+		if strings.TrimSpace(tokenFile) == "" {
+			return nil, fmt.Errorf("the token file for organization %q in organization_token_files is empty", organization)
+		}
+
+		log.Printf("[DEBUG] Reading the token for organization %q from %s", organization, tokenFile)
+		orgOpts := *opts
+		orgOpts.Token, orgOpts.TokenFile = "", tokenFile
+		client, err := GetClient(&orgOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure the client for organization %q: %w", organization, err)
+		}
+		clients[organization] = client
+	}
+
+	return clients, nil
+}
+
+// GetClientWithoutToken returns a client for the host in opts which fails every
+// API request with ErrMissingAuthToken. It stands in for the default client
+// when only organization_tokens or organization_token_files are configured, so
+// that resources which don't belong to one of those organizations fail with a
+// helpful error instead of being unable to configure the provider.
+func GetClientWithoutToken(opts *ClientOptions) (*tfe.Client, error) {
+	hostname, err := resolveHostname(opts.Hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := tfe.NewClient(&tfe.Config{
+		Address:    "https://" + hostname.String(),
+		Token:      "none",
+		HTTPClient: &http.Client{Transport: missingTokenTransport{}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	client.RetryServerErrors(false)
+
+	return client, nil
+}
+
+// missingTokenTransport answers the ping go-tfe sends when a client is created,
+// and fails every other request with ErrMissingAuthToken without sending it.
+type missingTokenTransport struct{}
+
+func (missingTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	if strings.HasSuffix(req.URL.Path, "/"+tfe.PingEndpoint) {
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Header:     http.Header{},
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+
+	return nil, ErrMissingAuthToken
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+func TestGetOrganizationClients(t *testing.T) {
+	srv := testServer(t)
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte(testToken+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The main token must not be used by the organization clients.
+	opts := &ClientOptions{
+		Hostname:      serverURL.Host,
+		Token:         "wrong-token",
+		SSLSkipVerify: true,
+	}
+
+	clients, err := GetOrganizationClients(opts, map[string]string{
+		"token": testToken,
+		"wrong": "other-token",
+	}, map[string]string{
+		"file": path,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting clients: %q", err)
+	}
+
+	for _, organization := range []string{"token", "file"} {
+		if _, err := clients[organization].Organizations.List(context.Background(), &tfe.OrganizationListOptions{}); err != nil {
+			t.Errorf("Unexpected error from using the client for %q: %q", organization, err)
+		}
+	}
+	if _, err := clients["wrong"].Organizations.List(context.Background(), &tfe.OrganizationListOptions{}); err == nil {
+		t.Error("Expected the client for \"wrong\" to be authenticated with its own token")
+	}
+
+	if _, err := GetOrganizationClients(opts, map[string]string{"empty": ""}, nil); err == nil {
+		t.Error("Expected an error for an empty token")
+	}
+	if _, err := GetOrganizationClients(opts, nil, map[string]string{"missing": filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Error("Expected an error for a missing token file")
+	}
+	if _, err := GetOrganizationClients(opts, map[string]string{"both": testToken}, map[string]string{"both": path}); err == nil {
+		t.Error("Expected an error for an organization with both a token and a token file")
+	}
+}
+
+func TestGetOrganizationClients_relativeTokenFile(t *testing.T) {
+	srv := testServer(t)
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	if err := os.WriteFile("org-a.token", []byte(testToken), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &ClientOptions{Hostname: serverURL.Host, SSLSkipVerify: true}
+	clients, err := GetOrganizationClients(opts, nil, map[string]string{"org-a": "org-a.token"})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting clients: %q", err)
+	}
+	if _, err := clients["org-a"].Organizations.List(context.Background(), &tfe.OrganizationListOptions{}); err != nil {
+		t.Errorf("Expected the token to be read from the relative path, got %q", err)
+	}
+}
+
+func TestGetClientWithoutToken(t *testing.T) {
+	client, err := GetClientWithoutToken(&ClientOptions{Hostname: "tfe.example.com"})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting the client: %q", err)
+	}
+
+	_, err = client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{})
+	if !errors.Is(err, ErrMissingAuthToken) {
+		t.Fatalf("Expected ErrMissingAuthToken, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// readCacheTransport deduplicates concurrent GET requests for the same URL and
+// caches successful JSON responses for a short time. Since the client, and so
+// its transport, is shared by the muxed provider servers, so is the cache. Any
+// request that isn't a GET invalidates every cached response, since a resource
+// can be read through other paths than the one it's changed through, such as a
+// workspace read by name and updated by ID.
+type readCacheTransport struct {
+	ttl      time.Duration
+	delegate http.RoundTripper
+	now      func() time.Time
+
+	mu       sync.Mutex
+	entries  map[string]*cachedResponse
+	inflight map[string]*inflightRead
+}
+
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+// inflightRead is a GET request in progress, whose response is shared with the
+// identical requests made while it's in progress.
+type inflightRead struct {
+	done     chan struct{}
+	response *cachedResponse
+	// stale is set when a change was made while the request was in flight,
+	// in which case the response is shared but not cached.
+	stale bool
+}
+
+func newReadCacheTransport(ttl time.Duration, delegate http.RoundTripper) *readCacheTransport {
+	return &readCacheTransport{
+		ttl:      ttl,
+		delegate: delegate,
+		now:      time.Now,
+		entries:  make(map[string]*cachedResponse),
+		inflight: make(map[string]*inflightRead),
+	}
+}
+
+func (t *readCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := t.delegate.RoundTrip(req)
+		// Invalidate once the change was made, so that reads made while the
+		// request was in flight aren't cached.
+		t.invalidate()
+		return resp, err
+	}
+
+	key := req.URL.String()
+
+	t.mu.Lock()
+	if cached, ok := t.entries[key]; ok {
+		if t.now().Before(cached.expires) {
+			t.mu.Unlock()
+			log.Printf("[TRACE] GET %s: using cached response", req.URL.Path)
+			return cached.response(req), nil
+		}
+		delete(t.entries, key)
+	}
+
+	if call, ok := t.inflight[key]; ok {
+		t.mu.Unlock()
+		<-call.done
+		if call.response != nil {
+			log.Printf("[TRACE] GET %s: using response of identical request in flight", req.URL.Path)
+			return call.response.response(req), nil
+		}
+		// The response couldn't be shared, so make the request on our own.
+		return t.delegate.RoundTrip(req)
+	}
+
+	call := &inflightRead{done: make(chan struct{})}
+	t.inflight[key] = call
+	t.mu.Unlock()
+
+	resp, err := t.delegate.RoundTrip(req)
+	if err == nil && cacheable(resp) {
+		call.response, err = t.buffer(req, resp)
+		if err != nil {
+			resp = nil
+		}
+	}
+
+	t.mu.Lock()
+	delete(t.inflight, key)
+	if call.response != nil && !call.stale {
+		t.entries[key] = call.response
+	}
+	t.mu.Unlock()
+	close(call.done)
+
+	if call.response != nil {
+		return call.response.response(req), nil
+	}
+	return resp, err
+}
+
+// buffer reads the response so it can be cached and shared.
+func (t *readCacheTransport) buffer(req *http.Request, resp *http.Response) (*cachedResponse, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachedResponse{
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		expires:    t.now().Add(t.ttl),
+	}, nil
+}
+
+// invalidate drops every cached response, and keeps the reads in flight from
+// being cached.
+func (t *readCacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	clear(t.entries)
+	for _, call := range t.inflight {
+		call.stale = true
+	}
+}
+
+// cacheable reports whether resp is a successful JSON response. Anything else,
+// such as errors or state and log downloads, is never cached.
+func cacheable(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/vnd.api+json" || mediaType == "application/json"
+}
+
+// response returns a copy of the cached response for req.
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.statusCode, http.StatusText(c.statusCode)),
+		StatusCode:    c.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReadCacheTransport(t *testing.T) {
+	var reads, listReads, nameReads int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/workspaces/ws-1":
+			n := atomic.AddInt32(&reads, 1)
+			fmt.Fprintf(w, `{"data": {"id": "ws-1", "attributes": {"read": %d}}}`, n)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/workspaces/ws-1/vars":
+			atomic.AddInt32(&listReads, 1)
+			io.WriteString(w, `{"data": []}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/org/workspaces/ws-name":
+			atomic.AddInt32(&nameReads, 1)
+			io.WriteString(w, `{"data": {"id": "ws-1"}}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	now := time.Now()
+	transport := newReadCacheTransport(time.Minute, http.DefaultTransport)
+	transport.now = func() time.Time { return now }
+
+	do := func(method, path string) string {
+		t.Helper()
+		req, _ := http.NewRequest(method, srv.URL+path, nil)
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return string(b)
+	}
+
+	first := do("GET", "/api/v2/workspaces/ws-1")
+	if second := do("GET", "/api/v2/workspaces/ws-1"); second != first || reads != 1 {
+		t.Fatalf("expected the second read to be cached, got %d reads", reads)
+	}
+
+	// Any change drops every cached read, including reads of the same
+	// resource through another path.
+	do("GET", "/api/v2/workspaces/ws-1/vars")
+	do("GET", "/api/v2/organizations/org/workspaces/ws-name")
+	do("PATCH", "/api/v2/workspaces/ws-1")
+	do("GET", "/api/v2/workspaces/ws-1")
+	do("GET", "/api/v2/workspaces/ws-1/vars")
+	do("GET", "/api/v2/organizations/org/workspaces/ws-name")
+	if reads != 2 || listReads != 2 || nameReads != 2 {
+		t.Fatalf("expected a mutation to invalidate the cache, got %d reads, %d list reads and %d reads by name", reads, listReads, nameReads)
+	}
+
+	do("POST", "/api/v2/organizations/org/teams")
+	do("GET", "/api/v2/workspaces/ws-1")
+	if reads != 3 {
+		t.Fatalf("expected an unrelated mutation to invalidate the cache, got %d reads", reads)
+	}
+
+	now = now.Add(2 * time.Minute)
+	do("GET", "/api/v2/workspaces/ws-1")
+	if reads != 4 {
+		t.Fatalf("expected the cached read to expire, got %d reads", reads)
+	}
+
+	// Errors are never cached.
+	req, _ := http.NewRequest("GET", srv.URL+"/api/v2/missing", nil)
+	for i := 0; i < 2; i++ {
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", resp.StatusCode)
+		}
+	}
+	if len(transport.entries) != 1 {
+		t.Fatalf("expected only successful reads to be cached, got %d entries", len(transport.entries))
+	}
+}
+
+func TestReadCacheTransport_deduplicatesInFlightReads(t *testing.T) {
+	var reads int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&reads, 1)
+		<-release
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		io.WriteString(w, `{"data": []}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	transport := newReadCacheTransport(time.Minute, http.DefaultTransport)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", srv.URL+"/api/v2/organizations/org/workspaces", nil)
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			b, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if string(b) != `{"data": []}` {
+				t.Errorf("unexpected body %q", b)
+			}
+		}()
+	}
+
+	// Wait for the first request to reach the server, and the others to queue
+	// up behind it.
+	for atomic.LoadInt32(&reads) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if reads != 1 {
+		t.Fatalf("expected identical reads in flight to be deduplicated, got %d reads", reads)
+	}
+}
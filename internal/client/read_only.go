@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// ErrReadOnly is returned for every request that could make a change while
+// read_only is enabled.
+var ErrReadOnly = errors.New("read_only is enabled, so no changes can be made")
+
+// readOnlyTransport rejects every request other than GET before it is sent.
+// It sits above the retry transport, so rejected requests aren't retried.
+type readOnlyTransport struct {
+	delegate http.RoundTripper
+}
+
+func newReadOnlyTransport(delegate http.RoundTripper) *readOnlyTransport {
+	return &readOnlyTransport{delegate: delegate}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		// A RoundTripper must close the request body, even on errors.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		log.Printf("[WARN] Refusing to send %s %s as read_only is enabled", req.Method, req.URL.Path)
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrReadOnly)
+	}
+
+	return t.delegate.RoundTrip(req)
+}
+
+// ReadOnly reports whether read_only is enabled, from the provider
+// configuration or TFE_READ_ONLY.
+func ReadOnly(opts *ClientOptions) (bool, error) {
+	if opts.ReadOnly != nil {
+		return *opts.ReadOnly, nil
+	}
+
+	v := os.Getenv("TFE_READ_ONLY")
+	if v == "" {
+		return false, nil
+	}
+
+	readOnly, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("TFE_READ_ONLY has unrecognized value %q", v)
+	}
+
+	return readOnly, nil
+}
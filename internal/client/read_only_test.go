@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	var methods []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := &http.Client{Transport: newReadOnlyTransport(http.DefaultTransport)}
+
+	resp, err := client.Get(srv.URL + "/api/v2/organizations")
+	if err != nil {
+		t.Fatalf("Unexpected error from GET: %q", err)
+	}
+	resp.Body.Close()
+
+	for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodDelete} {
+		req, _ := http.NewRequest(method, srv.URL+"/api/v2/organizations/hashicorp", strings.NewReader("{}"))
+		_, err := client.Do(req)
+		if err == nil || !strings.Contains(err.Error(), ErrReadOnly.Error()) {
+			t.Errorf("Expected %s to be rejected, got %v", method, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), method+" /api/v2/organizations/hashicorp") {
+			t.Errorf("Expected the error to name the endpoint, got %q", err)
+		}
+	}
+
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Fatalf("Expected only the GET request to be sent, got %v", methods)
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	enabled, disabled := true, false
+
+	cases := map[string]struct {
+		opts     ClientOptions
+		env      string
+		expected bool
+		err      bool
+	}{
+		"unset":                   {},
+		"attribute":               {opts: ClientOptions{ReadOnly: &enabled}, expected: true},
+		"env":                     {env: "true", expected: true},
+		"attribute overrides env": {opts: ClientOptions{ReadOnly: &disabled}, env: "true"},
+		"invalid env":             {env: "sometimes", err: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TFE_READ_ONLY", tc.env)
+
+			readOnly, err := ReadOnly(&tc.opts)
+			if (err != nil) != tc.err {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if readOnly != tc.expected {
+				t.Fatalf("Expected read only to be %t, got %t", tc.expected, readOnly)
+			}
+		})
+	}
+}
+
+func Test_GetClientReadOnly(t *testing.T) {
+	srv := testServer(t)
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	readOnly := true
+	client, err := GetClient(&ClientOptions{
+		Hostname:      serverURL.Host,
+		Token:         testToken,
+		SSLSkipVerify: true,
+		ReadOnly:      &readOnly,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting client: %q", err)
+	}
+
+	if _, err := client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{}); err != nil {
+		t.Errorf("Unexpected error from reading with the client: %q", err)
+	}
+
+	_, err = client.Organizations.Create(context.Background(), tfe.OrganizationCreateOptions{
+		Name:  tfe.String("hashicorp"),
+		Email: tfe.String("admin@example.com"),
+	})
+	if err == nil || !strings.Contains(err.Error(), ErrReadOnly.Error()) {
+		t.Errorf("Expected creating an organization to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-tfe/internal/logging"
+)
+
+const (
+	// DefaultMaxRetries matches the number of retries go-tfe performs on its own
+	// when it is left to handle rate limiting.
+	DefaultMaxRetries = 30
+
+	// DefaultRetryWaitMin is the shortest time to wait between two attempts when
+	// the server doesn't say how long to wait.
+	DefaultRetryWaitMin = 100 * time.Millisecond
+
+	// DefaultRetryWaitMax is the longest time to wait between two attempts when
+	// the server doesn't say how long to wait. Together with DefaultRetryWaitMin
+	// it matches the backoff go-tfe uses on its own.
+	DefaultRetryWaitMax = 400 * time.Millisecond
+
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+)
+
+// RetryConfig is the resolved retry policy applied to every request made by a
+// client.
+type RetryConfig struct {
+	MaxRetries        int
+	WaitMin           time.Duration
+	WaitMax           time.Duration
+	RetryServerErrors bool
+
+	// RetryNetworkErrors is only enabled when retry_server_errors is set to true
+	// explicitly, so that failing connections don't stall every request by
+	// default.
+	RetryNetworkErrors bool
+}
+
+// ErrRetriesExhausted is returned when a request is still being rate limited
+// after the configured number of retries.
+type ErrRetriesExhausted struct {
+	Method   string
+	Path     string
+	Attempts int
+}
+
+func (e *ErrRetriesExhausted) Error() string {
+	return fmt.Sprintf("%s %s: still rate limited after %d attempts; consider raising max_retries or retry_wait_max", e.Method, e.Path, e.Attempts)
+}
+
+// retryTransport retries requests that were rate limited (429), and optionally
+// requests that failed with a server error (>= 500), according to a
+// RetryConfig. Waits honour the Retry-After and X-RateLimit-Reset headers when
+// the server sends them.
+type retryTransport struct {
+	config   RetryConfig
+	delegate http.RoundTripper
+}
+
+func newRetryTransport(config RetryConfig, delegate http.RoundTripper) *retryTransport {
+	return &retryTransport{config: config, delegate: delegate}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	getBody, err := rewindableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		attemptReq := req
+		if attempt > 0 {
+			attemptReq = req.WithContext(logging.WithRetryAttempt(req.Context(), attempt))
+		}
+
+		resp, err := t.delegate.RoundTrip(attemptReq)
+
+		retry, reason := t.shouldRetry(resp, err)
+		if !retry {
+			return resp, err
+		}
+
+		if attempt >= t.config.MaxRetries {
+			if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+				// go-tfe retries 429 responses on its own without any limit we
+				// can configure, so hand it an error instead of the response to
+				// make sure max_retries is respected.
+				drainBody(resp)
+				return nil, &ErrRetriesExhausted{Method: req.Method, Path: req.URL.Path, Attempts: attempt + 1}
+			}
+			return resp, err
+		}
+
+		wait, source := t.backoff(attempt, resp)
+		log.Printf("[INFO] %s %s: %s, retrying in %s (attempt %d of %d, wait from %s)",
+			req.Method, req.URL.Path, reason, wait, attempt+1, t.config.MaxRetries, source)
+
+		drainBody(resp)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether the result of an attempt should be retried and
+// a short description of why.
+func (t *retryTransport) shouldRetry(resp *http.Response, err error) (bool, string) {
+	if err != nil {
+		if !t.config.RetryNetworkErrors {
+			return false, ""
+		}
+		return true, fmt.Sprintf("request failed: %v", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true, "rate limited"
+	case t.config.RetryServerErrors && resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return true, fmt.Sprintf("server error %d", resp.StatusCode)
+	}
+
+	return false, ""
+}
+
+// backoff returns the time to wait before the next attempt and where that
+// value came from. Server provided hints are preferred over exponential
+// backoff, and some jitter is always added to avoid a thundering herd.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) (time.Duration, string) {
+	jitter := time.Duration(0)
+	if spread := t.config.WaitMax - t.config.WaitMin; spread > 0 {
+		jitter = time.Duration(rand.Int63n(int64(spread)/4 + 1))
+	}
+
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get(headerRetryAfter)); ok {
+			return wait + jitter, headerRetryAfter + " header"
+		}
+
+		if resp.Header.Get(headerRateLimitRemaining) == "0" || resp.StatusCode == http.StatusTooManyRequests {
+			if reset, err := strconv.ParseFloat(resp.Header.Get(headerRateLimitReset), 64); err == nil && reset > 0 {
+				return time.Duration(reset*float64(time.Second)) + jitter, headerRateLimitReset + " header"
+			}
+		}
+	}
+
+	wait := float64(t.config.WaitMin) * math.Pow(2, float64(attempt))
+	if wait > float64(t.config.WaitMax) {
+		wait = float64(t.config.WaitMax)
+	}
+
+	// The jitter is taken off instead once the backoff would exceed WaitMax,
+	// so that waits are still spread when they are capped.
+	if backoff := time.Duration(wait) + jitter; backoff <= t.config.WaitMax {
+		return backoff, "exponential backoff"
+	}
+	return t.config.WaitMax - jitter, "exponential backoff"
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which may either be
+// a number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(v); err == nil {
+		wait := time.Until(at)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// rewindableBody returns a function that produces a fresh copy of the request
+// body for every retry, buffering the body if the request can't do it itself.
+func rewindableBody(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		return req.GetBody, nil
+	}
+
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(b))
+
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}, nil
+}
+
+func drainBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+}
+
+// resolveRetryConfig merges the provider-level retry settings with the
+// TFE_MAX_RETRIES, TFE_RETRY_WAIT_MIN, TFE_RETRY_WAIT_MAX and
+// TFE_RETRY_SERVER_ERRORS environment variables, falling back to defaults.
+func resolveRetryConfig(opts *ClientOptions) (RetryConfig, error) {
+	config := RetryConfig{
+		MaxRetries:        DefaultMaxRetries,
+		WaitMin:           DefaultRetryWaitMin,
+		WaitMax:           DefaultRetryWaitMax,
+		RetryServerErrors: true,
+	}
+
+	switch {
+	case opts.MaxRetries != nil:
+		config.MaxRetries = *opts.MaxRetries
+	case os.Getenv("TFE_MAX_RETRIES") != "":
+		v := os.Getenv("TFE_MAX_RETRIES")
+		maxRetries, err := strconv.Atoi(v)
+		if err != nil {
+			return config, fmt.Errorf("TFE_MAX_RETRIES has unrecognized value %q", v)
+		}
+		config.MaxRetries = maxRetries
+	}
+	if config.MaxRetries < 0 {
+		return config, fmt.Errorf("max_retries must not be negative, got %d", config.MaxRetries)
+	}
+
+	var err error
+	if config.WaitMin, err = durationOrEnv(opts.RetryWaitMin, "retry_wait_min", "TFE_RETRY_WAIT_MIN", DefaultRetryWaitMin); err != nil {
+		return config, err
+	}
+	if config.WaitMax, err = durationOrEnv(opts.RetryWaitMax, "retry_wait_max", "TFE_RETRY_WAIT_MAX", DefaultRetryWaitMax); err != nil {
+		return config, err
+	}
+	if config.WaitMin > config.WaitMax {
+		return config, fmt.Errorf("retry_wait_min (%s) must not be greater than retry_wait_max (%s)", config.WaitMin, config.WaitMax)
+	}
+
+	switch {
+	case opts.RetryServerErrors != nil:
+		config.RetryServerErrors = *opts.RetryServerErrors
+		config.RetryNetworkErrors = config.RetryServerErrors
+	case os.Getenv("TFE_RETRY_SERVER_ERRORS") != "":
+		v := os.Getenv("TFE_RETRY_SERVER_ERRORS")
+		config.RetryServerErrors, err = strconv.ParseBool(v)
+		if err != nil {
+			return config, fmt.Errorf("TFE_RETRY_SERVER_ERRORS has unrecognized value %q", v)
+		}
+		config.RetryNetworkErrors = config.RetryServerErrors
+	}
+
+	return config, nil
+}
+
+func durationOrEnv(value, attr, env string, def time.Duration) (time.Duration, error) {
+	source := attr
+	if value == "" {
+		value = os.Getenv(env)
+		source = env
+	}
+	if value == "" {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s has unrecognized duration %q: %w", source, value, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("%s must not be negative, got %q", source, value)
+	}
+
+	return d, nil
+}
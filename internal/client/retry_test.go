@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport_RoundTrip(t *testing.T) {
+	cases := map[string]struct {
+		statuses          []int
+		headers           http.Header
+		maxRetries        int
+		retryServerErrors bool
+		expectStatus      int
+		expectAttempts    int
+		expectExhausted   bool
+	}{
+		"success is not retried": {
+			statuses:       []int{200},
+			maxRetries:     3,
+			expectStatus:   200,
+			expectAttempts: 1,
+		},
+		"rate limited then success": {
+			statuses:       []int{429, 429, 200},
+			headers:        http.Header{"Retry-After": []string{"0"}},
+			maxRetries:     3,
+			expectStatus:   200,
+			expectAttempts: 3,
+		},
+		"rate limited until exhausted": {
+			statuses:        []int{429, 429, 429},
+			maxRetries:      2,
+			expectAttempts:  3,
+			expectExhausted: true,
+		},
+		"server error retried when enabled": {
+			statuses:          []int{502, 200},
+			maxRetries:        3,
+			retryServerErrors: true,
+			expectStatus:      200,
+			expectAttempts:    2,
+		},
+		"server error not retried when disabled": {
+			statuses:       []int{502, 200},
+			maxRetries:     3,
+			expectStatus:   502,
+			expectAttempts: 1,
+		},
+		"server error returned once exhausted": {
+			statuses:          []int{500, 500},
+			maxRetries:        1,
+			retryServerErrors: true,
+			expectStatus:      500,
+			expectAttempts:    2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != "payload" {
+					t.Errorf("expected request body to be replayed on attempt %d, got %q", attempts+1, body)
+				}
+				for k, v := range tc.headers {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(tc.statuses[attempts])
+				attempts++
+			}))
+			t.Cleanup(srv.Close)
+
+			transport := newRetryTransport(RetryConfig{
+				MaxRetries:        tc.maxRetries,
+				WaitMin:           time.Millisecond,
+				WaitMax:           5 * time.Millisecond,
+				RetryServerErrors: tc.retryServerErrors,
+			}, http.DefaultTransport)
+
+			req, err := http.NewRequest("POST", srv.URL+"/api/v2/organizations", strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.GetBody = nil
+
+			resp, err := transport.RoundTrip(req)
+			if tc.expectExhausted {
+				var exhausted *ErrRetriesExhausted
+				if !errors.As(err, &exhausted) {
+					t.Fatalf("expected ErrRetriesExhausted, got %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if resp.StatusCode != tc.expectStatus {
+					t.Fatalf("expected status %d, got %d", tc.expectStatus, resp.StatusCode)
+				}
+			}
+
+			if attempts != tc.expectAttempts {
+				t.Fatalf("expected %d attempts, got %d", tc.expectAttempts, attempts)
+			}
+		})
+	}
+}
+
+type failingTransport struct {
+	attempts int
+}
+
+func (f *failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	f.attempts++
+	return nil, errors.New("connection refused")
+}
+
+func TestRetryTransport_networkErrors(t *testing.T) {
+	for _, retryNetworkErrors := range []bool{false, true} {
+		delegate := &failingTransport{}
+		transport := newRetryTransport(RetryConfig{
+			MaxRetries:         2,
+			WaitMin:            time.Millisecond,
+			WaitMax:            time.Millisecond,
+			RetryServerErrors:  true,
+			RetryNetworkErrors: retryNetworkErrors,
+		}, delegate)
+
+		req, err := http.NewRequest("GET", "https://app.terraform.io/api/v2/ping", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := transport.RoundTrip(req); err == nil {
+			t.Fatalf("expected the network error to be returned")
+		}
+
+		expectAttempts := 1
+		if retryNetworkErrors {
+			expectAttempts = 3
+		}
+		if delegate.attempts != expectAttempts {
+			t.Errorf("RetryNetworkErrors %t: expected %d attempts, got %d", retryNetworkErrors, expectAttempts, delegate.attempts)
+		}
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	transport := newRetryTransport(RetryConfig{
+		MaxRetries: 5,
+		WaitMin:    time.Second,
+		WaitMax:    time.Second,
+	}, http.DefaultTransport)
+
+	cases := map[string]struct {
+		status       int
+		headers      http.Header
+		attempt      int
+		expectWait   time.Duration
+		expectSource string
+	}{
+		"retry-after seconds": {
+			status:       429,
+			headers:      http.Header{"Retry-After": []string{"7"}},
+			expectWait:   7 * time.Second,
+			expectSource: "Retry-After header",
+		},
+		"rate limit reset": {
+			status:       429,
+			headers:      http.Header{"X-Ratelimit-Reset": []string{"2.5"}},
+			expectWait:   2500 * time.Millisecond,
+			expectSource: "X-RateLimit-Reset header",
+		},
+		"exponential backoff is capped": {
+			status:       503,
+			attempt:      4,
+			expectWait:   time.Second,
+			expectSource: "exponential backoff",
+		},
+	}
+
+	for name, tc := range cases {
+		resp := &http.Response{StatusCode: tc.status, Header: tc.headers}
+		wait, source := transport.backoff(tc.attempt, resp)
+		if wait != tc.expectWait {
+			t.Errorf("%s: expected wait %s, got %s", name, tc.expectWait, wait)
+		}
+		if source != tc.expectSource {
+			t.Errorf("%s: expected source %q, got %q", name, tc.expectSource, source)
+		}
+	}
+}
+
+func TestRetryTransport_backoffJitter(t *testing.T) {
+	transport := newRetryTransport(RetryConfig{
+		MaxRetries: 5,
+		WaitMin:    100 * time.Millisecond,
+		WaitMax:    500 * time.Millisecond,
+	}, http.DefaultTransport)
+
+	for _, attempt := range []int{0, 10} {
+		waits := map[time.Duration]bool{}
+		for i := 0; i < 20; i++ {
+			wait, _ := transport.backoff(attempt, &http.Response{StatusCode: 503})
+			if wait < transport.config.WaitMin || wait > transport.config.WaitMax {
+				t.Fatalf("attempt %d: expected the wait to be within %s and %s, got %s", attempt, transport.config.WaitMin, transport.config.WaitMax, wait)
+			}
+			waits[wait] = true
+		}
+		if len(waits) == 1 {
+			t.Errorf("attempt %d: expected jitter to be added to the exponential backoff", attempt)
+		}
+	}
+}
+
+func TestRetryConfig_resolve(t *testing.T) {
+	zero := 0
+	disabled := false
+
+	cases := map[string]struct {
+		opts      ClientOptions
+		env       map[string]string
+		expect    RetryConfig
+		expectErr string
+	}{
+		"defaults": {
+			expect: RetryConfig{
+				MaxRetries:        DefaultMaxRetries,
+				WaitMin:           DefaultRetryWaitMin,
+				WaitMax:           DefaultRetryWaitMax,
+				RetryServerErrors: true,
+			},
+		},
+		"from env": {
+			env: map[string]string{
+				"TFE_MAX_RETRIES":         "5",
+				"TFE_RETRY_WAIT_MIN":      "1s",
+				"TFE_RETRY_WAIT_MAX":      "10s",
+				"TFE_RETRY_SERVER_ERRORS": "false",
+			},
+			expect: RetryConfig{
+				MaxRetries: 5,
+				WaitMin:    time.Second,
+				WaitMax:    10 * time.Second,
+			},
+		},
+		"provider config wins over env": {
+			opts: ClientOptions{
+				MaxRetries:        &zero,
+				RetryWaitMax:      "2s",
+				RetryServerErrors: &disabled,
+			},
+			env: map[string]string{
+				"TFE_MAX_RETRIES":         "5",
+				"TFE_RETRY_WAIT_MAX":      "10s",
+				"TFE_RETRY_SERVER_ERRORS": "true",
+			},
+			expect: RetryConfig{
+				MaxRetries: 0,
+				WaitMin:    DefaultRetryWaitMin,
+				WaitMax:    2 * time.Second,
+			},
+		},
+		"server errors enabled explicitly": {
+			env: map[string]string{
+				"TFE_RETRY_SERVER_ERRORS": "true",
+			},
+			expect: RetryConfig{
+				MaxRetries:         DefaultMaxRetries,
+				WaitMin:            DefaultRetryWaitMin,
+				WaitMax:            DefaultRetryWaitMax,
+				RetryServerErrors:  true,
+				RetryNetworkErrors: true,
+			},
+		},
+		"invalid duration": {
+			opts:      ClientOptions{RetryWaitMin: "soon"},
+			expectErr: `retry_wait_min has unrecognized duration "soon"`,
+		},
+		"min greater than max": {
+			opts:      ClientOptions{RetryWaitMin: "1m", RetryWaitMax: "1s"},
+			expectErr: "must not be greater than",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, k := range []string{"TFE_MAX_RETRIES", "TFE_RETRY_WAIT_MIN", "TFE_RETRY_WAIT_MAX", "TFE_RETRY_SERVER_ERRORS"} {
+				t.Setenv(k, tc.env[k])
+			}
+
+			config, err := resolveRetryConfig(&tc.opts)
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if config != tc.expect {
+				t.Fatalf("expected %+v, got %+v", tc.expect, config)
+			}
+		})
+	}
+}
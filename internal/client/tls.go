@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// TLSConfig holds the PEM material used to verify the server and to
+// authenticate the client with a certificate. File paths are read when the
+// configuration is resolved, so the material is what ends up being compared
+// between two configurations.
+type TLSConfig struct {
+	CACerts    []byte
+	ClientCert []byte
+	ClientKey  []byte
+}
+
+// resolveTLSConfig merges the provider-level TLS settings with the
+// TFE_CA_CERT_FILE, TFE_CA_CERT_PEM, TFE_CLIENT_CERT and TFE_CLIENT_KEY
+// environment variables and loads any referenced files.
+func resolveTLSConfig(opts *ClientOptions) (TLSConfig, error) {
+	config := TLSConfig{}
+
+	caCertFile := valueOrEnv(opts.CACertFile, "TFE_CA_CERT_FILE")
+	if caCertFile != "" {
+		b, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return config, fmt.Errorf("failed to read CA certificate file %q: %w", caCertFile, err)
+		}
+		config.CACerts = append(config.CACerts, b...)
+	}
+
+	if caCertPEM := valueOrEnv(opts.CACertPEM, "TFE_CA_CERT_PEM"); caCertPEM != "" {
+		if len(config.CACerts) > 0 {
+			config.CACerts = append(config.CACerts, '\n')
+		}
+		config.CACerts = append(config.CACerts, []byte(caCertPEM)...)
+	}
+
+	var err error
+	if config.ClientCert, err = pemOrFile(valueOrEnv(opts.ClientCert, "TFE_CLIENT_CERT"), "client certificate"); err != nil {
+		return config, err
+	}
+	if config.ClientKey, err = pemOrFile(valueOrEnv(opts.ClientKey, "TFE_CLIENT_KEY"), "client key"); err != nil {
+		return config, err
+	}
+
+	if (len(config.ClientCert) == 0) != (len(config.ClientKey) == 0) {
+		return config, errors.New("client_cert and client_key must be set together")
+	}
+
+	return config, nil
+}
+
+// apply loads the CA certificates and client certificate into the given
+// *tls.Config. The CA certificates are added to the system pool rather than
+// replacing it.
+func (c TLSConfig) apply(tlsConfig *tls.Config) error {
+	if len(c.CACerts) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			log.Printf("[WARN] Unable to load the system certificate pool, only the configured CA certificates will be trusted: %v", err)
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(c.CACerts) {
+			return errors.New("no valid PEM encoded certificates were found in ca_cert_file or ca_cert_pem")
+		}
+		tlsConfig.RootCAs = pool
+		log.Printf("[DEBUG] Client configured with custom CA certificates")
+	}
+
+	if len(c.ClientCert) > 0 {
+		cert, err := tls.X509KeyPair(c.ClientCert, c.ClientKey)
+		if err != nil {
+			return fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+		log.Printf("[DEBUG] Client configured with a client certificate")
+	}
+
+	return nil
+}
+
+// digest returns a stable summary of the TLS material suitable for use in a
+// cache key.
+func (c TLSConfig) digest() string {
+	h := sha256.New()
+	for _, b := range [][]byte{c.CACerts, c.ClientCert, c.ClientKey} {
+		h.Write(b)
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func valueOrEnv(value, env string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(env)
+}
+
+// pemOrFile returns value itself if it is PEM encoded, otherwise it treats
+// value as a path and returns the content of that file.
+func pemOrFile(value, description string) ([]byte, error) {
+	if value == "" || strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	b, err := os.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s file %q: %w", description, value, err)
+	}
+	return b, nil
+}
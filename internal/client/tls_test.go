@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+// testClientCertificate generates a self-signed client certificate and returns
+// it and its key, PEM encoded.
+func testClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-tfe"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM)
+}
+
+func TestTLSConfig_resolve(t *testing.T) {
+	certPEM, keyPEM := testClientCertificate(t)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	if err := os.WriteFile(certFile, []byte(certPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+	caFile := filepath.Join(dir, "ca.crt")
+	if err := os.WriteFile(caFile, []byte(certPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		opts      ClientOptions
+		env       map[string]string
+		expectErr string
+		expectCA  bool
+		expectKey bool
+	}{
+		"nothing configured": {},
+		"CA from file and PEM": {
+			opts:     ClientOptions{CACertFile: caFile, CACertPEM: certPEM},
+			expectCA: true,
+		},
+		"client certificate from file and key inline": {
+			opts:      ClientOptions{ClientCert: certFile, ClientKey: keyPEM},
+			expectKey: true,
+		},
+		"client certificate from env": {
+			env:       map[string]string{"TFE_CLIENT_CERT": certPEM, "TFE_CLIENT_KEY": keyPEM},
+			expectKey: true,
+		},
+		"client certificate without key": {
+			opts:      ClientOptions{ClientCert: certPEM},
+			expectErr: "must be set together",
+		},
+		"missing CA file": {
+			opts:      ClientOptions{CACertFile: filepath.Join(dir, "missing.crt")},
+			expectErr: "failed to read CA certificate file",
+		},
+		"invalid CA": {
+			opts:      ClientOptions{CACertPEM: "-----BEGIN CERTIFICATE-----\nnope\n-----END CERTIFICATE-----"},
+			expectErr: "no valid PEM encoded certificates",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, k := range []string{"TFE_CA_CERT_FILE", "TFE_CA_CERT_PEM", "TFE_CLIENT_CERT", "TFE_CLIENT_KEY"} {
+				t.Setenv(k, tc.env[k])
+			}
+
+			config, err := resolveTLSConfig(&tc.opts)
+			if err == nil {
+				err = config.apply(&tls.Config{})
+			}
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if (len(config.CACerts) > 0) != tc.expectCA {
+				t.Fatalf("expected CA certificates to be loaded: %v", tc.expectCA)
+			}
+			if (len(config.ClientKey) > 0) != tc.expectKey {
+				t.Fatalf("expected client key to be loaded: %v", tc.expectKey)
+			}
+		})
+	}
+}
+
+func TestTLSConfig_keyDiffers(t *testing.T) {
+	certPEM, keyPEM := testClientCertificate(t)
+
+	base := ClientConfiguration{TFEHost: "app.terraform.io", Token: testToken}
+	withCA := base
+	withCA.TLS = TLSConfig{CACerts: []byte(certPEM)}
+	withClientCert := base
+	withClientCert.TLS = TLSConfig{ClientCert: []byte(certPEM), ClientKey: []byte(keyPEM)}
+
+	keys := map[string]bool{}
+	for _, c := range []ClientConfiguration{base, withCA, withClientCert} {
+		keys[c.Key()] = true
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected differently configured TLS settings to produce distinct keys, got %d distinct keys", len(keys))
+	}
+}
+
+func Test_GetClientWithCACert(t *testing.T) {
+	srv := testServer(t)
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	t.Setenv("TFE_SSL_SKIP_VERIFY", "")
+	client, err := GetClient(&ClientOptions{
+		Hostname:  serverURL.Host,
+		Token:     testToken,
+		CACertPEM: string(caPEM),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting client: %q", err)
+	}
+
+	_, err = client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{})
+	if err != nil {
+		t.Errorf("Unexpected error from using client: %q", err)
+	}
+}
+
+func Test_GetClientWithClientCert(t *testing.T) {
+	certPEM, keyPEM := testClientCertificate(t)
+
+	// The test server trusts our self-signed client certificate.
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM([]byte(certPEM))
+
+	mux := http.NewServeMux()
+	for route, handler := range testDefaultRequestHandlers {
+		mux.HandleFunc(route, handler)
+	}
+	srv := httptest.NewUnstartedServer(mux)
+	srv.TLS = &tls.Config{ClientCAs: pool, ClientAuth: tls.RequireAndVerifyClientCert}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	_, err = GetClient(&ClientOptions{
+		Hostname:      serverURL.Host,
+		Token:         testToken,
+		SSLSkipVerify: true,
+	})
+	if err == nil {
+		t.Fatal("Expected an error connecting without a client certificate")
+	}
+
+	client, err := GetClient(&ClientOptions{
+		Hostname:      serverURL.Host,
+		Token:         testToken,
+		SSLSkipVerify: true,
+		ClientCert:    certPEM,
+		ClientKey:     keyPEM,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting client: %q", err)
+	}
+
+	_, err = client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{})
+	if err != nil {
+		t.Errorf("Unexpected error from using client: %q", err)
+	}
+}
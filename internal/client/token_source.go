@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// tokenRefreshWindow is how long before its expiry a token is refreshed.
+	tokenRefreshWindow = 2 * time.Minute
+
+	tokenExchangeGrantType   = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenExchangeSubjectType = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// tokenSource supplies the token used to authenticate API requests, for tokens
+// that may change over the lifetime of a client.
+type tokenSource interface {
+	Token() (string, error)
+
+	// Refresh discards the current token and fetches it again from its
+	// origin. It is called when the API rejects the current token.
+	Refresh() (string, error)
+}
+
+// authTransport sets the Authorization header of every request from a
+// tokenSource, replacing the static token go-tfe was created with. When the
+// API responds with 401 Unauthorized, the token is refreshed and the request
+// retried once if the token changed.
+type authTransport struct {
+	source   tokenSource
+	delegate http.RoundTripper
+}
+
+func newAuthTransport(source tokenSource, delegate http.RoundTripper) *authTransport {
+	return &authTransport{source: source, delegate: delegate}
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	getBody, err := rewindableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.delegate.RoundTrip(withToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	refreshed, refreshErr := t.source.Refresh()
+	if refreshErr != nil {
+		log.Printf("[WARN] Failed to refresh token after 401 Unauthorized: %v", refreshErr)
+		return resp, nil
+	}
+	if refreshed == token {
+		return resp, nil
+	}
+
+	log.Printf("[DEBUG] %s %s: token was rejected and has changed since, retrying with the new token", req.Method, req.URL.Path)
+	drainBody(resp)
+
+	if getBody != nil {
+		body, err := getBody()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = body
+	}
+
+	return t.delegate.RoundTrip(withToken(req, refreshed))
+}
+
+// withToken returns a copy of req authenticated with token, as a RoundTripper
+// must not modify the request it was given.
+func withToken(req *http.Request, token string) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+// fileTokenSource reads the token from a file, which is read again when the
+// token is rejected so that tokens rotated on disk are picked up.
+type fileTokenSource struct {
+	path string
+
+	mu    sync.Mutex
+	token string
+}
+
+func newFileTokenSource(path string) (*fileTokenSource, error) {
+	s := &fileTokenSource{path: path}
+	if _, err := s.Refresh(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *fileTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token, nil
+}
+
+func (s *fileTokenSource) Refresh() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", s.path)
+	}
+
+	if s.token != "" && token != s.token {
+		log.Printf("[DEBUG] Token in %s has changed", s.path)
+	}
+	s.token = token
+
+	return s.token, nil
+}
+
+// workloadIdentityTokenSource authenticates with a short-lived workload
+// identity JWT, either by using it directly as the API token or by exchanging
+// it for an access token. The token is refreshed shortly before it expires.
+type workloadIdentityTokenSource struct {
+	readJWT     func() (string, error)
+	exchangeURL string
+	httpClient  *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// workloadIdentitySource returns a token source for workload identity
+// authentication, or nil when it isn't configured. It is enabled either by
+// pointing workload_identity_token_file at a file that is kept up to date with
+// a fresh JWT, or by setting workload_identity_auth to true to use the
+// TFC_WORKLOAD_IDENTITY_TOKEN provided to HCP Terraform and Terraform
+// Enterprise runs. When workload_identity_exchange_url is set, the JWT is
+// exchanged for an access token at that URL. Each attribute defaults to its
+// TFE_WORKLOAD_IDENTITY_* environment variable.
+func workloadIdentitySource(opts *ClientOptions, httpClient *http.Client) (*workloadIdentityTokenSource, error) {
+	exchangeURL := opts.WorkloadIdentityExchangeURL
+	if exchangeURL == "" {
+		exchangeURL = os.Getenv("TFE_WORKLOAD_IDENTITY_EXCHANGE_URL")
+	}
+	source := &workloadIdentityTokenSource{
+		exchangeURL: exchangeURL,
+		httpClient:  httpClient,
+	}
+
+	path := opts.WorkloadIdentityTokenFile
+	if path == "" {
+		path = os.Getenv("TFE_WORKLOAD_IDENTITY_TOKEN_FILE")
+	}
+	if path != "" {
+		log.Printf("[DEBUG] Using workload identity token from %s", path)
+		source.readJWT = func() (string, error) {
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return "", fmt.Errorf("failed to read workload identity token file: %w", err)
+			}
+			return strings.TrimSpace(string(b)), nil
+		}
+		return source, nil
+	}
+
+	var enabled bool
+	if opts.WorkloadIdentityAuth != nil {
+		enabled = *opts.WorkloadIdentityAuth
+	} else if v := os.Getenv("TFE_WORKLOAD_IDENTITY_AUTH"); v != "" {
+		var err error
+		enabled, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("TFE_WORKLOAD_IDENTITY_AUTH has unrecognized value %q", v)
+		}
+	}
+	if enabled {
+		log.Printf("[DEBUG] Using workload identity token from TFC_WORKLOAD_IDENTITY_TOKEN")
+		source.readJWT = func() (string, error) {
+			jwt := os.Getenv("TFC_WORKLOAD_IDENTITY_TOKEN")
+			if jwt == "" {
+				return "", errors.New("workload identity authentication is enabled but TFC_WORKLOAD_IDENTITY_TOKEN is not set")
+			}
+			return jwt, nil
+		}
+		return source, nil
+	}
+
+	return nil, nil
+}
+
+// Token returns the current token, refreshing it first if it expires soon.
+func (s *workloadIdentityTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || time.Until(s.expiry) > tokenRefreshWindow) {
+		return s.token, nil
+	}
+
+	return s.refresh()
+}
+
+func (s *workloadIdentityTokenSource) Refresh() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refresh()
+}
+
+func (s *workloadIdentityTokenSource) refresh() (string, error) {
+	jwt, err := s.readJWT()
+	if err != nil {
+		return "", err
+	}
+	if jwt == "" {
+		return "", errors.New("workload identity token is empty")
+	}
+
+	token, expiry := jwt, jwtExpiry(jwt)
+	if s.exchangeURL != "" {
+		token, expiry, err = s.exchange(jwt)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if s.token != "" && token != s.token {
+		log.Printf("[DEBUG] Workload identity token refreshed, now expires at %s", expiry)
+	}
+	if !expiry.IsZero() && time.Until(expiry) <= 0 {
+		log.Printf("[WARN] Workload identity token expired at %s and no fresh token is available", expiry)
+	}
+
+	s.token, s.expiry = token, expiry
+	return s.token, nil
+}
+
+// exchange trades the JWT for an access token using an OAuth 2.0 token
+// exchange (RFC 8693) request.
+func (s *workloadIdentityTokenSource) exchange(jwt string) (string, time.Time, error) {
+	form := url.Values{
+		"grant_type":         {tokenExchangeGrantType},
+		"subject_token":      {jwt},
+		"subject_token_type": {tokenExchangeSubjectType},
+	}
+
+	resp, err := s.httpClient.PostForm(s.exchangeURL, form)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to exchange workload identity token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("failed to exchange workload identity token: %s", resp.Status)
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", time.Time{}, fmt.Errorf("malformed workload identity token exchange response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return "", time.Time{}, errors.New("workload identity token exchange response did not include an access_token")
+	}
+
+	expiry := time.Time{}
+	if result.ExpiresIn > 0 {
+		expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+
+	return result.AccessToken, expiry, nil
+}
+
+// jwtExpiry returns the time set in the exp claim of a JWT, or the zero time
+// if the token isn't a JWT or has no expiry. The signature isn't verified as
+// the API does that.
+func jwtExpiry(jwt string) time.Time {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp json.Number `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}
+	}
+
+	exp, err := claims.Exp.Float64()
+	if err != nil || exp <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(exp), 0)
+}
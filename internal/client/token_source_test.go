@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+// testJWT builds an unsigned JWT expiring at the given time.
+func testJWT(t *testing.T, subject string, exp time.Time) string {
+	t.Helper()
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]interface{}{"sub": subject, "exp": exp.Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fmt.Sprintf("%s.%s.signature", header, base64.RawURLEncoding.EncodeToString(claims))
+}
+
+func TestJWTExpiry(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	if got := jwtExpiry(testJWT(t, "run", exp)); !got.Equal(exp) {
+		t.Fatalf("expected expiry %s, got %s", exp, got)
+	}
+	if got := jwtExpiry("not-a-jwt"); !got.IsZero() {
+		t.Fatalf("expected no expiry for an opaque token, got %s", got)
+	}
+}
+
+func TestWorkloadIdentityTokenSource_refreshesFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.jwt")
+	t.Setenv("TFE_WORKLOAD_IDENTITY_TOKEN_FILE", path)
+	t.Setenv("TFE_WORKLOAD_IDENTITY_EXCHANGE_URL", "")
+
+	// The first token is about to expire, so it should be refreshed on the
+	// next call.
+	first := testJWT(t, "first", time.Now().Add(time.Minute))
+	if err := os.WriteFile(path, []byte(first+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := workloadIdentitySource(&ClientOptions{}, http.DefaultClient)
+	if err != nil || source == nil {
+		t.Fatalf("expected a workload identity source, got %v, %v", source, err)
+	}
+
+	token, err := source.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != first {
+		t.Fatalf("expected the token read from the file, got %q", token)
+	}
+
+	second := testJWT(t, "second", time.Now().Add(time.Hour))
+	if err := os.WriteFile(path, []byte(second), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err = source.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != second {
+		t.Fatalf("expected the token to be refreshed before expiry, got %q", token)
+	}
+
+	// The second token is valid for a while, so the file isn't read again.
+	if err := os.WriteFile(path, []byte(first), 0600); err != nil {
+		t.Fatal(err)
+	}
+	token, _ = source.Token()
+	if token != second {
+		t.Fatalf("expected the cached token to be used, got %q", token)
+	}
+}
+
+func TestWorkloadIdentityTokenSource_fromEnv(t *testing.T) {
+	t.Setenv("TFE_WORKLOAD_IDENTITY_TOKEN_FILE", "")
+	t.Setenv("TFE_WORKLOAD_IDENTITY_AUTH", "")
+
+	source, err := workloadIdentitySource(&ClientOptions{}, http.DefaultClient)
+	if err != nil || source != nil {
+		t.Fatalf("expected workload identity to be disabled, got %v, %v", source, err)
+	}
+
+	jwt := testJWT(t, "run", time.Now().Add(time.Hour))
+	t.Setenv("TFE_WORKLOAD_IDENTITY_AUTH", "true")
+	t.Setenv("TFC_WORKLOAD_IDENTITY_TOKEN", jwt)
+
+	source, err = workloadIdentitySource(&ClientOptions{}, http.DefaultClient)
+	if err != nil || source == nil {
+		t.Fatalf("expected a workload identity source, got %v, %v", source, err)
+	}
+	token, err := source.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != jwt {
+		t.Fatalf("expected TFC_WORKLOAD_IDENTITY_TOKEN to be used, got %q", token)
+	}
+}
+
+func TestWorkloadIdentityTokenSource_fromOptions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.jwt")
+	t.Setenv("TFE_WORKLOAD_IDENTITY_TOKEN_FILE", "")
+	t.Setenv("TFE_WORKLOAD_IDENTITY_AUTH", "true")
+	t.Setenv("TFE_WORKLOAD_IDENTITY_EXCHANGE_URL", "https://env.example.com/exchange")
+
+	jwt := testJWT(t, "file", time.Now().Add(time.Hour))
+	if err := os.WriteFile(path, []byte(jwt), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := workloadIdentitySource(&ClientOptions{
+		WorkloadIdentityTokenFile:   path,
+		WorkloadIdentityExchangeURL: "https://config.example.com/exchange",
+	}, http.DefaultClient)
+	if err != nil || source == nil {
+		t.Fatalf("expected a workload identity source, got %v, %v", source, err)
+	}
+	if source.exchangeURL != "https://config.example.com/exchange" {
+		t.Fatalf("expected the configured exchange URL to take precedence, got %q", source.exchangeURL)
+	}
+	if token, err := source.readJWT(); err != nil || token != jwt {
+		t.Fatalf("expected the JWT to be read from the configured file, got %q, %v", token, err)
+	}
+
+	// Disabling it in configuration overrides the environment.
+	source, err = workloadIdentitySource(&ClientOptions{WorkloadIdentityAuth: tfe.Bool(false)}, http.DefaultClient)
+	if err != nil || source != nil {
+		t.Fatalf("expected workload identity to be disabled, got %v, %v", source, err)
+	}
+}
+
+func TestWorkloadIdentityTokenSource_exchange(t *testing.T) {
+	jwt := testJWT(t, "run", time.Now().Add(time.Hour))
+
+	exchanges := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("grant_type") != tokenExchangeGrantType || r.PostForm.Get("subject_token") != jwt {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		exchanges++
+		w.Header().Set("Content-Type", "application/json")
+		// Expire within the refresh window, so every call exchanges again.
+		fmt.Fprintf(w, `{"access_token": "exchanged-%d", "expires_in": 60}`, exchanges)
+	}))
+	t.Cleanup(srv.Close)
+
+	source := &workloadIdentityTokenSource{
+		readJWT:     func() (string, error) { return jwt, nil },
+		exchangeURL: srv.URL,
+		httpClient:  srv.Client(),
+	}
+
+	for i := 1; i <= 2; i++ {
+		token, err := source.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := fmt.Sprintf("exchanged-%d", i); token != want {
+			t.Fatalf("expected %q, got %q", want, token)
+		}
+	}
+}
+
+func Test_GetClientWithWorkloadIdentity(t *testing.T) {
+	srv := testServer(t)
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte(testToken), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TFE_TOKEN", "")
+	t.Setenv("TFE_WORKLOAD_IDENTITY_TOKEN_FILE", path)
+	t.Setenv("TFE_WORKLOAD_IDENTITY_EXCHANGE_URL", "")
+
+	client, err := GetClient(&ClientOptions{Hostname: serverURL.Host, SSLSkipVerify: true})
+	if err != nil {
+		t.Fatalf("Unexpected error when getting client: %q", err)
+	}
+
+	_, err = client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{})
+	if err != nil {
+		t.Errorf("Unexpected error from using client: %q", err)
+	}
+}
+
+func TestAuthTransport_reloadsTokenFileOn401(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("old-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	source, err := newFileTokenSource(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := newAuthTransport(source, http.DefaultTransport)
+
+	req, _ := http.NewRequest("GET", srv.URL, nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized || attempts != 1 {
+		t.Fatalf("expected a single rejected attempt while the token is unchanged, got %d after %d attempts", resp.StatusCode, attempts)
+	}
+
+	// Rotate the token on disk.
+	if err := os.WriteFile(path, []byte("new-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	attempts = 0
+	req, _ = http.NewRequest("GET", srv.URL, nil)
+	resp, err = transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || attempts != 2 {
+		t.Fatalf("expected the request to be retried with the rotated token, got %d after %d attempts", resp.StatusCode, attempts)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Fatal("expected the original request not to be modified")
+	}
+}
+
+func Test_GetClientWithTokenFile(t *testing.T) {
+	srv := testServer(t)
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing testServer URL: %q", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte(testToken+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		opts ClientOptions
+		env  map[string]string
+	}{
+		"token_file attribute": {
+			opts: ClientOptions{TokenFile: path},
+			env:  map[string]string{"TFE_TOKEN": "wrong-token"},
+		},
+		"TFE_TOKEN_FILE": {
+			env: map[string]string{"TFE_TOKEN": "", "TFE_TOKEN_FILE": path},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			tc.opts.Hostname = serverURL.Host
+			tc.opts.SSLSkipVerify = true
+
+			client, err := GetClient(&tc.opts)
+			if err != nil {
+				t.Fatalf("Unexpected error when getting client: %q", err)
+			}
+
+			_, err = client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{})
+			if err != nil {
+				t.Errorf("Unexpected error from using client: %q", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// EnvHTTPRecord is the directory in which to record every API interaction.
+	EnvHTTPRecord = "TFE_HTTP_RECORD"
+
+	// EnvHTTPReplay is the directory from which to replay recorded API
+	// interactions instead of sending requests over the network.
+	EnvHTTPReplay = "TFE_HTTP_REPLAY"
+)
+
+// cassetteHeaders is a list of headers whose values are never written to a
+// cassette.
+var cassetteHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+var unsafePathChars = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// interaction is a recorded request/response pair, as stored on disk.
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// cassetteTransport records API interactions to a directory, or replays them
+// from one, so that whole plans and applies can be run without a network.
+type cassetteTransport struct {
+	dir      string
+	replay   bool
+	delegate http.RoundTripper
+
+	mu sync.Mutex
+	// seen counts the requests made for each interaction key, so repeated
+	// requests (like polling a run) are recorded and replayed in order.
+	seen map[string]int
+}
+
+// NewCassetteTransport wraps the given transport to record every interaction to
+// the directory named by TFE_HTTP_RECORD, or to replay them from the directory
+// named by TFE_HTTP_REPLAY. When neither is set, the transport is returned as-is.
+func NewCassetteTransport(t http.RoundTripper) http.RoundTripper {
+	if dir := os.Getenv(EnvHTTPReplay); dir != "" {
+		log.Printf("[INFO] Replaying HTTP interactions from %s, no requests will be sent", dir)
+		return &cassetteTransport{dir: dir, replay: true, delegate: t, seen: map[string]int{}}
+	}
+
+	if dir := os.Getenv(EnvHTTPRecord); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			log.Printf("[ERROR] Unable to create %s directory %s, HTTP interactions won't be recorded: %v", EnvHTTPRecord, dir, err)
+			return t
+		}
+		log.Printf("[INFO] Recording HTTP interactions to %s", dir)
+		return &cassetteTransport{dir: dir, delegate: t, seen: map[string]int{}}
+	}
+
+	return t
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	scrubbedReqBody := redactBody(req.URL.Path, reqBody)
+
+	name := t.nextName(req, scrubbedReqBody)
+	path := filepath.Join(t.dir, name)
+
+	if t.replay {
+		return t.replayInteraction(req, path)
+	}
+
+	resp, err := t.delegate.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := interaction{
+		Request: recordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: scrubHeaders(req.Header),
+			Body:    scrubbedReqBody,
+		},
+		Response: recordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    scrubHeaders(resp.Header),
+			Body:       redactBody(req.URL.Path, respBody),
+		},
+	}
+
+	b, err := json.MarshalIndent(recorded, "", "  ")
+	if err == nil {
+		err = os.WriteFile(path, b, 0o600)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to record HTTP interaction %s %s: %v", req.Method, req.URL.Path, err)
+	}
+
+	return resp, nil
+}
+
+func (t *cassetteTransport) replayInteraction(req *http.Request, path string) (*http.Response, error) {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		// Requests repeated more often than during the recording, such as
+		// polling, keep getting the last recorded response.
+		if last := t.lastRecorded(path); last != "" {
+			b, err = os.ReadFile(last)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("no recorded interaction for %s %s in %s: %w", req.Method, req.URL.RequestURI(), t.dir, err)
+	}
+
+	var recorded interaction
+	if err := json.Unmarshal(b, &recorded); err != nil {
+		return nil, fmt.Errorf("malformed recorded interaction %s: %w", path, err)
+	}
+
+	header := recorded.Response.Headers
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Response.StatusCode, http.StatusText(recorded.Response.StatusCode)),
+		StatusCode:    recorded.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Response.Body)),
+		ContentLength: int64(len(recorded.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// nextName returns the file name of the next interaction for the request. Names
+// are derived from the request rather than the order in which requests are
+// made, as Terraform walks the graph concurrently.
+func (t *cassetteTransport) nextName(req *http.Request, body string) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + req.URL.RequestURI() + "\x00" + body))
+	slug := strings.Trim(unsafePathChars.ReplaceAllString(req.URL.Path, "-"), "-")
+	if len(slug) > 80 {
+		slug = slug[len(slug)-80:]
+	}
+	key := fmt.Sprintf("%s-%s-%x", req.Method, slug, sum[:6])
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.seen[key]++
+
+	return fmt.Sprintf("%s-%03d.json", key, t.seen[key])
+}
+
+// lastRecorded returns the last recorded interaction sharing the key of path.
+func (t *cassetteTransport) lastRecorded(path string) string {
+	prefix := strings.TrimSuffix(path, filepath.Ext(path))
+	prefix = prefix[:strings.LastIndex(prefix, "-")+1]
+
+	matches, err := filepath.Glob(prefix + "[0-9][0-9][0-9].json")
+	if err != nil || len(matches) == 0 {
+		return ""
+	}
+	sort.Strings(matches)
+
+	return matches[len(matches)-1]
+}
+
+// readBody reads a request or response body and replaces it with a copy that
+// can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(b))
+
+	return b, nil
+}
+
+func scrubHeaders(h http.Header) http.Header {
+	scrubbed := h.Clone()
+	for _, name := range cassetteHeaders {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, redactedValue)
+		}
+	}
+	return scrubbed
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteTransport_disabled(t *testing.T) {
+	t.Setenv(EnvHTTPRecord, "")
+	t.Setenv(EnvHTTPReplay, "")
+
+	transport := &http.Transport{}
+	if got := NewCassetteTransport(transport); got != transport {
+		t.Fatalf("expected the transport to be returned as-is, got %T", got)
+	}
+}
+
+func TestCassetteTransport_recordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		switch r.URL.Path {
+		case "/.well-known/terraform.json":
+			io.WriteString(w, `{"tfe.v2": "/api/v2/"}`)
+		case "/api/v2/runs/run-1":
+			polls++
+			if polls == 1 {
+				io.WriteString(w, `{"data": {"attributes": {"status": "planning"}}}`)
+				return
+			}
+			io.WriteString(w, `{"data": {"attributes": {"status": "planned"}}}`)
+		case "/api/v2/vars":
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"data": {"attributes": {"key": "password", "value": "hunter2", "sensitive": true}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	do := func(transport http.RoundTripper, method, path, body string) (int, string) {
+		t.Helper()
+
+		var reqBody io.Reader
+		if body != "" {
+			reqBody = strings.NewReader(body)
+		}
+		req, _ := http.NewRequest(method, srv.URL+path, reqBody)
+		req.Header.Set("Authorization", "Bearer secret-token")
+
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(b)
+	}
+
+	createVar := `{"data": {"type": "vars", "attributes": {"key": "password", "value": "hunter2", "sensitive": true}}}`
+
+	t.Setenv(EnvHTTPReplay, "")
+	t.Setenv(EnvHTTPRecord, dir)
+	recorder := NewCassetteTransport(http.DefaultTransport)
+
+	do(recorder, "GET", "/.well-known/terraform.json", "")
+	do(recorder, "GET", "/api/v2/runs/run-1", "")
+	do(recorder, "GET", "/api/v2/runs/run-1", "")
+	if _, body := do(recorder, "POST", "/api/v2/vars", createVar); !strings.Contains(body, "hunter2") {
+		t.Fatalf("expected the live response to be returned unmodified, got %s", body)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 4 {
+		t.Fatalf("expected 4 recorded interactions, got %d", len(files))
+	}
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, secret := range []string{"secret-token", "secret-cookie", "hunter2"} {
+			if strings.Contains(string(b), secret) {
+				t.Fatalf("expected %s not to contain %q:\n%s", filepath.Base(file), secret, b)
+			}
+		}
+	}
+
+	// Replay with the server gone, so nothing can be answered over the network.
+	srv.Close()
+	t.Setenv(EnvHTTPRecord, "")
+	t.Setenv(EnvHTTPReplay, dir)
+	player := NewCassetteTransport(http.DefaultTransport)
+
+	if _, body := do(player, "GET", "/.well-known/terraform.json", ""); !strings.Contains(body, "tfe.v2") {
+		t.Fatalf("expected service discovery to be replayed, got %s", body)
+	}
+	if status, _ := do(player, "POST", "/api/v2/vars", createVar); status != http.StatusCreated {
+		t.Fatalf("expected the recorded status, got %d", status)
+	}
+	for _, expected := range []string{"planning", "planned", "planned"} {
+		if _, body := do(player, "GET", "/api/v2/runs/run-1", ""); !strings.Contains(body, `"`+expected+`"`) {
+			t.Fatalf("expected a run that is %s, got %s", expected, body)
+		}
+	}
+
+	req, _ := http.NewRequest("GET", srv.URL+"/api/v2/unrecorded", nil)
+	if _, err := player.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Fatalf("expected an error for an unrecorded request, got %v", err)
+	}
+}
@@ -4,16 +4,16 @@
 package logging
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
+	"context"
 	"io"
-	"log"
 	"net/http"
-	"net/http/httputil"
 	"os"
-	"regexp"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
 type loggingTransport struct {
@@ -22,76 +22,168 @@ type loggingTransport struct {
 }
 
 const (
-	EnvLog = "TF_LOG"
+	EnvLog         = "TF_LOG"
+	EnvLogProvider = "TF_LOG_PROVIDER"
+
+	// SubsystemHTTP is the tflog subsystem API requests are logged to. Its
+	// level can be set on its own with TF_LOG_PROVIDER_TFE_HTTP.
+	SubsystemHTTP = "tfe_http"
+)
+
+// Fields set on every entry logged for an API request.
+const (
+	KeyHTTPTransport    = "tfe_http_transport"
+	KeyHTTPMethod       = "tfe_http_method"
+	KeyHTTPPath         = "tfe_http_path"
+	KeyHTTPStatus       = "tfe_http_status"
+	KeyHTTPDuration     = "tfe_http_duration_ms"
+	KeyHTTPRequestID    = "tfe_http_request_id"
+	KeyHTTPRetryAttempt = "tfe_http_retry_attempt"
+	KeyHTTPHeaders      = "tfe_http_headers"
+	KeyHTTPBody         = "tfe_http_body"
+	KeyError            = "error"
 )
 
-// redactedHeaders is a list of lowercase headers (with trailing colons) that signal that the
-// header values should be redacted from logs
-var redactedHeaders = []string{"authorization:", "proxy-authorization:"}
+// redactedHeaders is a list of headers whose values are redacted from logs.
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization"}
+
+var (
+	fallbackLogContext     context.Context
+	fallbackLogContextOnce sync.Once
+)
+
+type retryAttemptKey struct{}
+
+// WithRetryAttempt returns a copy of ctx recording that a request made with it
+// is the given retry of the original request, for the logs of that request.
+func WithRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptKey{}, attempt)
+}
+
+// RetryAttempt returns the retry attempt recorded in ctx by WithRetryAttempt,
+// or 0 for the original request.
+func RetryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptKey{}).(int)
+	return attempt
+}
 
-// logLevelSet reads the TF_LOG level and ensures it is valid
+// logLevelSet reads the TF_LOG and TF_LOG_PROVIDER levels and ensures one of
+// them is valid
 func logLevelSet() bool {
-	level := strings.ToUpper(os.Getenv(EnvLog))
-	// Ensure its set to a valid level otherwise will default logging to TRACE
-	switch level {
-	case "DEBUG", "TRACE", "INFO", "WARN", "ERROR":
-		return true
-	default:
-		return false
+	for _, env := range []string{EnvLog, EnvLogProvider} {
+		level := strings.ToUpper(os.Getenv(env))
+		// Ensure its set to a valid level otherwise will default logging to TRACE
+		switch level {
+		case "DEBUG", "TRACE", "INFO", "WARN", "ERROR", "JSON":
+			return true
+		}
 	}
+	return false
 }
 
-// RoundTrip is a transport method that logs the request and response if the TF_LOG level is
-// TRACE or DEBUG
+// logContext returns a context holding the tfe_http subsystem logger. Requests
+// made during an RPC carry the provider logger set up by the plugin server,
+// along with fields such as tf_resource_type and tf_req_id. Requests made with
+// a context that doesn't have one are logged through a provider logger of our
+// own, without those fields.
+func logContext(ctx context.Context) context.Context {
+	if subsystemCtx := newSubsystem(ctx); subsystemCtx != ctx {
+		return subsystemCtx
+	}
+
+	fallbackLogContextOnce.Do(func() {
+		fallbackLogContext = tfsdklog.NewRootProviderLogger(context.Background(),
+			tfsdklog.WithLogName("provider"),
+			tfsdklog.WithLevelFromEnv(EnvLogProvider),
+			tfsdklog.WithoutLocation(),
+		)
+	})
+
+	return newSubsystem(fallbackLogContext)
+}
+
+// newSubsystem returns ctx with the tfe_http subsystem logger, or ctx itself
+// when it doesn't have a provider logger.
+func newSubsystem(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, SubsystemHTTP,
+		tflog.WithRootFields(),
+		tflog.WithLevelFromEnv(EnvLogProvider, strings.ToUpper(SubsystemHTTP)),
+	)
+}
+
+// RoundTrip is a transport method that logs the request and response to the
+// tfe_http subsystem if TF_LOG or TF_LOG_PROVIDER is set. Request and response
+// details are logged at DEBUG, and their headers and bodies at TRACE.
 func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	includeBody := !hasSensitiveValues(req)
-
-	// We don't need any logic to handle each specific level as
-	// Terraform will log accordingly based on the prefix.
-	if logLevelSet() {
-		reqData, err := httputil.DumpRequestOut(req, includeBody)
-		if err == nil {
-			log.Printf("[DEBUG] "+logReqMsg, t.name, filterAndPrettyPrintLines(reqData, includeBody))
-		} else {
-			log.Printf("[ERROR] %s API Request error: %#v", t.name, err)
-		}
+	if !logLevelSet() {
+		return t.delegate.RoundTrip(req)
 	}
 
+	ctx := logContext(req.Context())
+	ctx = tflog.SubsystemSetField(ctx, SubsystemHTTP, KeyHTTPTransport, t.name)
+	ctx = tflog.SubsystemSetField(ctx, SubsystemHTTP, KeyHTTPMethod, req.Method)
+	ctx = tflog.SubsystemSetField(ctx, SubsystemHTTP, KeyHTTPPath, req.URL.RequestURI())
+	if attempt := RetryAttempt(req.Context()); attempt > 0 {
+		ctx = tflog.SubsystemSetField(ctx, SubsystemHTTP, KeyHTTPRetryAttempt, attempt)
+	}
+
+	tflog.SubsystemDebug(ctx, SubsystemHTTP, t.name+" API request")
+	tflog.SubsystemTrace(ctx, SubsystemHTTP, t.name+" API request details", map[string]interface{}{
+		KeyHTTPHeaders: redactHeaders(req.Header),
+		KeyHTTPBody:    logBody(req.URL.Path, &req.Body),
+	})
+
+	start := time.Now()
 	resp, err := t.delegate.RoundTrip(req)
+	ctx = tflog.SubsystemSetField(ctx, SubsystemHTTP, KeyHTTPDuration, time.Since(start).Milliseconds())
 	if err != nil {
+		tflog.SubsystemDebug(ctx, SubsystemHTTP, t.name+" API request failed", map[string]interface{}{
+			KeyError: err.Error(),
+		})
 		return resp, err
 	}
 
-	if logLevelSet() {
-		respData, err := httputil.DumpResponse(resp, includeBody)
-		if err == nil {
-			if strings.Contains(string(respData), "404 Not Found") {
-				log.Printf("[WARN] The requested resource at %s %s could not be found. Please ensure no drift occurred by attempting to import the desired resource. It may also be that your token is invalid.", req.Method, req.URL.RequestURI())
-			}
-			log.Printf("[DEBUG] "+logRespMsg, t.name, filterAndPrettyPrintLines(respData, includeBody))
-		} else {
-			log.Printf("[ERROR] %s API Response error: %#v", t.name, err)
-		}
+	ctx = tflog.SubsystemSetField(ctx, SubsystemHTTP, KeyHTTPStatus, resp.StatusCode)
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		ctx = tflog.SubsystemSetField(ctx, SubsystemHTTP, KeyHTTPRequestID, requestID)
 	}
 
+	if resp.StatusCode == http.StatusNotFound {
+		tflog.SubsystemWarn(ctx, SubsystemHTTP, "The requested resource could not be found. Please ensure no drift occurred by attempting to import the desired resource. It may also be that your token is invalid.")
+	}
+
+	tflog.SubsystemDebug(ctx, SubsystemHTTP, t.name+" API response")
+	tflog.SubsystemTrace(ctx, SubsystemHTTP, t.name+" API response details", map[string]interface{}{
+		KeyHTTPHeaders: redactHeaders(resp.Header),
+		KeyHTTPBody:    logBody(req.URL.Path, &resp.Body),
+	})
+
 	return resp, nil
 }
 
-func hasSensitiveValues(req *http.Request) bool {
-	foundSensitiveVal := false
-	if req.Body != nil {
-		b, err := io.ReadAll(req.Body)
-		if err != nil {
-			return true // just to be safe, let's assume there could be a sensitive value
-		}
+// logBody returns the body to log with secrets masked, replacing it with a
+// copy that can be read again.
+func logBody(path string, body *io.ReadCloser) string {
+	b, err := readBody(body)
+	if err != nil {
+		return "[BODY UNAVAILABLE: " + err.Error() + "]"
+	}
+
+	return redactBody(path, b)
+}
 
-		if regexp.MustCompile(`"sensitive":true`).MatchString(string(b)) {
-			foundSensitiveVal = true
+// redactHeaders returns the headers to log, with sensitive values redacted.
+func redactHeaders(h http.Header) map[string]string {
+	headers := make(map[string]string, len(h))
+	for name, values := range h {
+		headers[name] = strings.Join(values, ", ")
+	}
+	for _, name := range redactedHeaders {
+		if _, ok := headers[name]; ok {
+			headers[name] = redactedValue
 		}
-		// after done inspecting the body, place back same data we read
-		req.Body = io.NopCloser(bytes.NewBuffer(b))
 	}
-	return foundSensitiveVal
+	return headers
 }
 
 // NewLoggingTransport wraps the given transport with a logger that logs request and
@@ -99,41 +191,3 @@ func hasSensitiveValues(req *http.Request) bool {
 func NewLoggingTransport(name string, t http.RoundTripper) *loggingTransport {
 	return &loggingTransport{name, t}
 }
-
-// filterAndPrettyPrintLines iterates through a []byte line-by-line,
-// redacting any sensitive lines and transforming any lines that are complete json into
-// pretty-printed json.
-func filterAndPrettyPrintLines(b []byte, includeBody bool) string {
-	sanitizedParts := strings.TrimSpace(string(b))
-	parts := strings.Split(sanitizedParts, "\n")
-
-	for i, p := range parts {
-		for _, check := range redactedHeaders {
-			if strings.HasPrefix(strings.ToLower(p), check) {
-				// This looks like a sensitive header to redact, so overwrite the entire line
-				parts[i] = fmt.Sprintf("%s <REDACTED>", p[0:len(check)])
-				continue
-			}
-		}
-		if b := []byte(p); json.Valid(b) {
-			var out bytes.Buffer
-			_ = json.Indent(&out, b, "", " ") // already checked for validity
-			parts[i] = out.String()
-		}
-	}
-
-	if !includeBody {
-		parts = append(parts, "[BODY REDACTED: Due to sensitive values present]")
-	}
-	return strings.Join(parts, "\n")
-}
-
-const logReqMsg = `%s API Request Details:
----[ REQUEST ]---------------------------------------
-%s
------------------------------------------------------`
-
-const logRespMsg = `%s API Response Details:
----[ RESPONSE ]--------------------------------------
-%s
------------------------------------------------------`
@@ -4,11 +4,112 @@
 package logging
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
 func TestLoggingNewLoggingTransport_IsRoundTripper(t *testing.T) {
 	transport := NewLoggingTransport("example", &http.Transport{})
 	var _ http.RoundTripper = transport
 }
+
+func TestLoggingTransport_structuredEntries(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "provider.log")
+	t.Setenv(EnvLog, "JSON")
+	t.Setenv("TF_LOG_PATH", logFile)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		io.WriteString(w, `{"data": {"id": "ws-1"}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := tfsdklog.RegisterTestSink(context.Background(), t)
+	ctx = tfsdklog.NewRootProviderLogger(ctx)
+	ctx = tflog.SetField(ctx, "tf_resource_type", "tfe_workspace")
+
+	transport := NewLoggingTransport("TFE", http.DefaultTransport)
+	for i, path := range []string{"/api/v2/workspaces/ws-1", "/missing"} {
+		req, _ := http.NewRequestWithContext(WithRetryAttempt(ctx, i), "GET", srv.URL+path, nil)
+		req.Header.Set("Authorization", "Bearer secret-token")
+
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if i == 0 && !strings.Contains(string(b), "ws-1") {
+			t.Fatalf("expected the response body to still be readable, got %q", b)
+		}
+	}
+
+	b, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("expected JSON log entries, got %q", line)
+		}
+		if entry["@module"] == "provider."+SubsystemHTTP {
+			entries = append(entries, entry)
+		}
+	}
+
+	find := func(message string, attempt int) map[string]interface{} {
+		t.Helper()
+		for _, entry := range entries {
+			got, _ := entry[KeyHTTPRetryAttempt].(float64)
+			if entry["@message"] == message && int(got) == attempt {
+				return entry
+			}
+		}
+		t.Fatalf("expected a %q entry for attempt %d in:\n%s", message, attempt, b)
+		return nil
+	}
+
+	response := find("TFE API response", 0)
+	if response["@level"] != "debug" || response[KeyHTTPStatus] != float64(200) ||
+		response[KeyHTTPMethod] != "GET" || response[KeyHTTPPath] != "/api/v2/workspaces/ws-1" ||
+		response[KeyHTTPRequestID] != "req-123" || response["tf_resource_type"] != "tfe_workspace" {
+		t.Fatalf("unexpected response entry: %v", response)
+	}
+	if _, ok := response[KeyHTTPDuration]; !ok {
+		t.Fatalf("expected the response entry to include the duration: %v", response)
+	}
+	if _, ok := response[KeyHTTPBody]; ok {
+		t.Fatalf("expected the body not to be logged at DEBUG: %v", response)
+	}
+
+	details := find("TFE API response details", 0)
+	if details["@level"] != "trace" || !strings.Contains(details[KeyHTTPBody].(string), "ws-1") {
+		t.Fatalf("expected the body to be logged at TRACE: %v", details)
+	}
+
+	notFound := find("The requested resource could not be found. Please ensure no drift occurred by attempting to import the desired resource. It may also be that your token is invalid.", 1)
+	if notFound["@level"] != "warn" || notFound[KeyHTTPStatus] != float64(404) {
+		t.Fatalf("unexpected not found entry: %v", notFound)
+	}
+
+	if strings.Contains(string(b), "secret-token") {
+		t.Fatalf("expected the Authorization header to be redacted:\n%s", b)
+	}
+}
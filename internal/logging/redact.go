@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sync"
+)
+
+// redactedValue replaces the value of every secret that is logged or recorded.
+const redactedValue = "<REDACTED>"
+
+// AnyType matches resources of every JSON:API type in SensitiveFields.
+const AnyType = "*"
+
+// SensitiveFields describes the attributes of a JSON:API resource type that
+// hold secrets, which are masked in logged and recorded request and response
+// bodies.
+type SensitiveFields struct {
+	// Type is the JSON:API resource type, such as "oauth-clients", or AnyType.
+	Type string
+
+	// Attributes are the names of the attributes to mask, such as
+	// "private-key".
+	Attributes []string
+
+	// PathPattern, when set, limits the rule to requests whose path matches
+	// it. This is needed for endpoints sharing a resource type with others,
+	// such as registry module test variables, which are "vars".
+	PathPattern *regexp.Regexp
+}
+
+var (
+	sensitiveFieldsMu sync.RWMutex
+	sensitiveFields   = []SensitiveFields{
+		{Type: AnyType, Attributes: []string{"token", "secret", "password", "private-key", "hmac-key"}},
+		{Type: "oauth-clients", Attributes: []string{"oauth-token-string"}},
+		{Type: "oauth-tokens", Attributes: []string{"ssh-key"}},
+		{Type: "ssh-keys", Attributes: []string{"value"}},
+		{Type: "twilio-settings", Attributes: []string{"auth-token"}},
+		{Type: "cost-estimation-settings", Attributes: []string{"aws-secret-key", "azure-client-secret"}},
+		{Type: "vars", Attributes: []string{"value"}, PathPattern: regexp.MustCompile(`/tests/registry-modules/`)},
+	}
+)
+
+// RegisterSensitiveFields adds attributes to mask in logged and recorded
+// bodies. Resources that send or receive secrets in attributes that aren't
+// covered yet should register them, usually from an init function. The value
+// attribute of anything marked "sensitive" is always masked.
+func RegisterSensitiveFields(fields SensitiveFields) {
+	sensitiveFieldsMu.Lock()
+	defer sensitiveFieldsMu.Unlock()
+
+	sensitiveFields = append(sensitiveFields, fields)
+}
+
+// redactBody masks secret attributes of the resources in a JSON:API document,
+// keeping the rest of it. Bodies that aren't JSON are returned as-is.
+func redactBody(path string, b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return string(b)
+	}
+
+	redacted, err := json.Marshal(redactDocument(path, doc))
+	if err != nil {
+		return string(b)
+	}
+
+	return string(redacted)
+}
+
+// redactDocument masks secret attributes of the primary and included resources
+// of a decoded JSON:API document.
+func redactDocument(path string, doc interface{}) interface{} {
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return doc
+	}
+
+	for _, member := range []string{"data", "included"} {
+		switch v := m[member].(type) {
+		case map[string]interface{}:
+			redactResource(path, v)
+		case []interface{}:
+			for _, resource := range v {
+				if resource, ok := resource.(map[string]interface{}); ok {
+					redactResource(path, resource)
+				}
+			}
+		}
+	}
+
+	return m
+}
+
+func redactResource(path string, resource map[string]interface{}) {
+	attributes, ok := resource["attributes"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	resourceType, _ := resource["type"].(string)
+
+	mask := func(name string) {
+		if v, ok := attributes[name]; ok && v != nil {
+			attributes[name] = redactedValue
+		}
+	}
+
+	if sensitive, _ := attributes["sensitive"].(bool); sensitive {
+		mask("value")
+	}
+
+	sensitiveFieldsMu.RLock()
+	defer sensitiveFieldsMu.RUnlock()
+
+	for _, fields := range sensitiveFields {
+		if fields.Type != AnyType && fields.Type != resourceType {
+			continue
+		}
+		if fields.PathPattern != nil && !fields.PathPattern.MatchString(path) {
+			continue
+		}
+		for _, name := range fields.Attributes {
+			mask(name)
+		}
+	}
+}
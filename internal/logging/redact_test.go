@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]struct {
+		path     string
+		body     string
+		expected string
+	}{
+		"sensitive variable": {
+			path:     "/api/v2/workspaces/ws-1/vars",
+			body:     `{"data": {"type": "vars", "attributes": {"key": "password", "value": "hunter2", "sensitive": true}}}`,
+			expected: `{"data": {"type": "vars", "attributes": {"key": "password", "value": "<REDACTED>", "sensitive": true}}}`,
+		},
+		"variable that isn't sensitive": {
+			path:     "/api/v2/workspaces/ws-1/vars",
+			body:     `{"data": {"type": "vars", "attributes": {"key": "region", "value": "eu-west-1", "sensitive": false}}}`,
+			expected: `{"data": {"type": "vars", "attributes": {"key": "region", "value": "eu-west-1", "sensitive": false}}}`,
+		},
+		"test variable": {
+			path:     "/api/v2/organizations/org/tests/registry-modules/private/org/name/aws/vars",
+			body:     `{"data": {"type": "vars", "attributes": {"key": "AWS_SECRET_ACCESS_KEY", "value": "abc123", "sensitive": false}}}`,
+			expected: `{"data": {"type": "vars", "attributes": {"key": "AWS_SECRET_ACCESS_KEY", "value": "<REDACTED>", "sensitive": false}}}`,
+		},
+		"run task hmac key": {
+			path:     "/api/v2/organizations/org/tasks",
+			body:     `{"data": {"type": "tasks", "attributes": {"name": "scan", "url": "https://example.com", "hmac-key": "s3cr3t"}}}`,
+			expected: `{"data": {"type": "tasks", "attributes": {"name": "scan", "url": "https://example.com", "hmac-key": "<REDACTED>"}}}`,
+		},
+		"oauth client": {
+			path:     "/api/v2/organizations/org/oauth-clients",
+			body:     `{"data": {"type": "oauth-clients", "attributes": {"service-provider": "github", "oauth-token-string": "ghp_abc", "private-key": "-----BEGIN", "rsa-public-key": "ssh-rsa AAA"}}}`,
+			expected: `{"data": {"type": "oauth-clients", "attributes": {"service-provider": "github", "oauth-token-string": "<REDACTED>", "private-key": "<REDACTED>", "rsa-public-key": "ssh-rsa AAA"}}}`,
+		},
+		"notification configuration list": {
+			path:     "/api/v2/workspaces/ws-1/notification-configurations",
+			body:     `{"data": [{"type": "notification-configurations", "attributes": {"name": "slack", "token": "abc"}}, {"type": "notification-configurations", "attributes": {"name": "email", "token": null}}]}`,
+			expected: `{"data": [{"type": "notification-configurations", "attributes": {"name": "slack", "token": "<REDACTED>"}}, {"type": "notification-configurations", "attributes": {"name": "email", "token": null}}]}`,
+		},
+		"included resources": {
+			path:     "/api/v2/workspaces/ws-1",
+			body:     `{"data": {"type": "workspaces", "attributes": {"name": "ws"}}, "included": [{"type": "ssh-keys", "attributes": {"name": "key", "value": "-----BEGIN"}}]}`,
+			expected: `{"data": {"type": "workspaces", "attributes": {"name": "ws"}}, "included": [{"type": "ssh-keys", "attributes": {"name": "key", "value": "<REDACTED>"}}]}`,
+		},
+		"large numbers are kept": {
+			path:     "/api/v2/runs/run-1",
+			body:     `{"data": {"type": "runs", "attributes": {"resource-additions": 12345678901234567890}}}`,
+			expected: `{"data": {"type": "runs", "attributes": {"resource-additions": 12345678901234567890}}}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assertJSONEqual(t, tc.expected, redactBody(tc.path, []byte(tc.body)))
+		})
+	}
+}
+
+func TestRedactBody_notJSON(t *testing.T) {
+	body := "plain text log output"
+	if got := redactBody("/api/v2/plans/plan-1/logs", []byte(body)); got != body {
+		t.Fatalf("expected the body to be returned as-is, got %q", got)
+	}
+}
+
+func TestRegisterSensitiveFields(t *testing.T) {
+	registered := sensitiveFields
+	t.Cleanup(func() { sensitiveFields = registered })
+
+	body := `{"data": {"type": "widgets", "attributes": {"name": "w", "api-key": "abc"}}}`
+	assertJSONEqual(t, body, redactBody("/api/v2/widgets", []byte(body)))
+
+	RegisterSensitiveFields(SensitiveFields{
+		Type:        "widgets",
+		Attributes:  []string{"api-key"},
+		PathPattern: regexp.MustCompile(`^/api/v2/widgets`),
+	})
+	assertJSONEqual(t,
+		`{"data": {"type": "widgets", "attributes": {"name": "w", "api-key": "<REDACTED>"}}}`,
+		redactBody("/api/v2/widgets", []byte(body)))
+	assertJSONEqual(t, body, redactBody("/api/v2/other", []byte(body)))
+}
+
+func assertJSONEqual(t *testing.T, expected, actual string) {
+	t.Helper()
+
+	var e, a interface{}
+	if err := json.Unmarshal([]byte(expected), &e); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(actual), &a); err != nil {
+		t.Fatalf("expected JSON, got %q", actual)
+	}
+	if !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected %s, got %s", expected, actual)
+	}
+}
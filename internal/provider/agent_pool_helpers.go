@@ -4,12 +4,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
 )
 
-func fetchAgentPool(orgName string, poolName string, client *tfe.Client) (*tfe.AgentPool, error) {
+func fetchAgentPool(ctx context.Context, orgName string, poolName string, client *tfe.Client) (*tfe.AgentPool, error) {
 	// to reduce the number of pages returned, search based on the name. TFE instances which
 	// do not support agent pool search will just ignore the query parameter
 	options := tfe.AgentPoolListOptions{
@@ -19,7 +19,8 @@ type AttrGettable interface {
 
 // dataOrDefaultOrganization returns the value of the "organization" attribute
 // from the Config/Plan/State data, defaulting to the provier configuration.
-// If neither is set, an error is returned.
+// If neither is set, an error is returned. Client is switched to the client for
+// that organization.
 func (c *ConfiguredClient) dataOrDefaultOrganization(ctx context.Context, data AttrGettable, target *string) diag.Diagnostics {
 	schemaPath := path.Root("organization")
 
@@ -37,5 +38,42 @@ func (c *ConfiguredClient) dataOrDefaultOrganization(ctx context.Context, data A
 		*target = c.Organization
 	}
 
+	if err := c.useOrganization(*target); err != nil {
+		diags.AddAttributeError(schemaPath, "No token is configured for the organization", err.Error())
+	}
+	return diags
+}
+
+// useDataOrganization switches Client to the client for the organization of
+// the Config/Plan/State data, defaulting to the provider configuration. Unlike
+// dataOrDefaultOrganization, it is not an error if neither is set, so it can be
+// used by every operation of a resource with an organization.
+func (c *ConfiguredClient) useDataOrganization(ctx context.Context, data AttrGettable) diag.Diagnostics {
+	schemaPath := path.Root("organization")
+
+	var organization types.String
+	diags := data.GetAttribute(ctx, schemaPath, &organization)
+	if diags.HasError() {
+		return diags
+	}
+
+	target := c.Organization
+	if !organization.IsNull() && !organization.IsUnknown() {
+		target = organization.ValueString()
+	}
+
+	if err := c.useOrganization(target); err != nil {
+		diags.AddAttributeError(schemaPath, "No token is configured for the organization", err.Error())
+	}
+	return diags
+}
+
+// useImportOrganization switches Client to the client for the organization
+// of an import ID.
+func (c *ConfiguredClient) useImportOrganization(organization string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := c.useOrganization(organization); err != nil {
+		diags.AddError("No token is configured for the organization", err.Error())
+	}
 	return diags
 }
@@ -4,6 +4,12 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
 	tfe "github.com/hashicorp/go-tfe"
 )
 
@@ -29,3 +35,139 @@ func (r *defaultCapabilityResolver) IsCloud() bool {
 func (r *defaultCapabilityResolver) RemoteTFEVersion() string {
 	return r.client.RemoteTFEVersion()
 }
+
+// capabilityResolver returns the capabilities of the host, as told by the
+// client for organization. It returns nil when they can't be told, since the
+// provider isn't configured, or has no default token and no token for the
+// organization either, in which case the client reports no capabilities.
+func (c ConfiguredClient) capabilityResolver(organization string) capabilitiesResolver {
+	if client, ok := c.organizationClients[organization]; ok {
+		return newDefaultCapabilityResolver(client)
+	}
+	if c.Client == nil || c.defaultTokenMissing {
+		return nil
+	}
+	return newDefaultCapabilityResolver(c.Client)
+}
+
+// tfeVersionPattern matches Terraform Enterprise release names, such as
+// v202404-1.
+var tfeVersionPattern = regexp.MustCompile(`^v(\d{6})-(\d+)$`)
+
+// firstReportedTFEVersion is the first Terraform Enterprise release reporting
+// its version. Earlier releases report an empty version.
+const firstReportedTFEVersion = "v202208-3"
+
+// capability describes the hosts supporting a resource or an attribute.
+type capability struct {
+	// minTFEVersion is the first Terraform Enterprise release supporting it,
+	// such as "v202404-1". It is supported by every release when empty.
+	minTFEVersion string
+	// cloudOnly is set when it is only supported by HCP Terraform.
+	cloudOnly bool
+	// enterpriseOnly is set when it is only supported by Terraform Enterprise,
+	// such as the admin API.
+	enterpriseOnly bool
+	// warnOnly is set when the provider falls back to an older API on hosts
+	// that don't support it, so the plan is warned rather than failed.
+	warnOnly bool
+}
+
+// capabilityRegistry maps resource types, and attributes as
+// "<resource type>.<attribute>", to the hosts supporting them. Resources and
+// attributes that aren't registered are supported everywhere.
+//
+// It's checked at plan time, by ModifyPlan and CustomizeDiff, rather than by
+// ValidateConfig, which runs before the provider is configured, when the host
+// isn't known yet.
+var capabilityRegistry = map[string]capability{
+	"tfe_admin_organization_settings":          {enterpriseOnly: true},
+	"tfe_data_retention_policy":                {enterpriseOnly: true},
+	"tfe_opa_version":                          {enterpriseOnly: true},
+	"tfe_organization.assessments_enforced":    {cloudOnly: true},
+	"tfe_organization_module_sharing":          {enterpriseOnly: true},
+	"tfe_project":                              {minTFEVersion: "v202302-1"},
+	"tfe_saml_settings":                        {enterpriseOnly: true},
+	"tfe_sentinel_version":                     {enterpriseOnly: true},
+	"tfe_stack":                                {cloudOnly: true},
+	"tfe_team_project_access":                  {minTFEVersion: "v202302-1"},
+	"tfe_team_project_access.project_access":   {minTFEVersion: "v202308-1"},
+	"tfe_team_project_access.workspace_access": {minTFEVersion: "v202308-1"},
+	"tfe_terraform_version":                    {enterpriseOnly: true},
+	"tfe_workspace.project_id":                 {minTFEVersion: "v202302-1"},
+	"tfe_workspace_run_task.stages":            {minTFEVersion: "v202404-1", warnOnly: true},
+}
+
+// checkCapability returns an error naming what is required when the host
+// doesn't support key, a resource type or attribute from capabilityRegistry.
+// It returns nil when the host supports it, or when the host or its version
+// can't tell.
+func checkCapability(r capabilitiesResolver, key string) error {
+	c, ok := capabilityRegistry[key]
+	if !ok || r == nil {
+		return nil
+	}
+
+	if r.IsCloud() {
+		if c.enterpriseOnly {
+			return fmt.Errorf("%s is only available in Terraform Enterprise", key)
+		}
+		return nil
+	}
+
+	if c.cloudOnly {
+		return fmt.Errorf("%s is only available in HCP Terraform", key)
+	}
+	if c.minTFEVersion == "" {
+		return nil
+	}
+
+	version := r.RemoteTFEVersion()
+	if version == "" {
+		// Releases before v202208-3 don't report their version, so only the
+		// capabilities introduced after it are known to be missing.
+		if compareTFEVersions(c.minTFEVersion, firstReportedTFEVersion) <= 0 {
+			return nil
+		}
+		version = "a release earlier than " + firstReportedTFEVersion
+	} else if compareTFEVersions(version, c.minTFEVersion) >= 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s requires HCP Terraform or Terraform Enterprise %s or later, but the host runs %s", key, c.minTFEVersion, version)
+}
+
+// compareTFEVersions compares two Terraform Enterprise releases, returning a
+// negative number when a is earlier than b, and a positive one when a is later.
+// Versions that aren't release names are treated as the latest release.
+func compareTFEVersions(a, b string) int {
+	am, bm := tfeVersionPattern.FindStringSubmatch(a), tfeVersionPattern.FindStringSubmatch(b)
+	switch {
+	case am == nil && bm == nil:
+		return 0
+	case am == nil:
+		return 1
+	case bm == nil:
+		return -1
+	}
+
+	if am[1] != bm[1] {
+		return strings.Compare(am[1], bm[1])
+	}
+	an, _ := strconv.Atoi(am[2])
+	bn, _ := strconv.Atoi(bm[2])
+	return an - bn
+}
+
+// registeredAttributes returns the attributes of resourceType in
+// capabilityRegistry.
+func registeredAttributes(resourceType string) []string {
+	var attrs []string
+	for key := range capabilityRegistry {
+		if attr, ok := strings.CutPrefix(key, resourceType+"."); ok {
+			attrs = append(attrs, attr)
+		}
+	}
+	sort.Strings(attrs)
+	return attrs
+}
@@ -3,6 +3,18 @@
 
 package provider
 
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
 var _ capabilitiesResolver = &staticCapabilityResolver{}
 
 // A mock capability resolver used for testing to set specific capabilities
@@ -26,3 +38,196 @@ func (r *staticCapabilityResolver) SetIsCloud(val bool) {
 func (r *staticCapabilityResolver) SetRemoteTFEVersion(val string) {
 	r.tfeVer = val
 }
+
+func TestCheckCapability(t *testing.T) {
+	testCases := map[string]struct {
+		key         string
+		isCloud     bool
+		tfeVer      string
+		expectError string
+	}{
+		"unregistered":                            {"tfe_workspace.name", false, "v202001-1", ""},
+		"HCP Terraform only in HCP Terraform":     {"tfe_stack", true, "", ""},
+		"HCP Terraform only in Enterprise":        {"tfe_stack", false, "v202408-1", "tfe_stack is only available in HCP Terraform"},
+		"Enterprise only in Enterprise":           {"tfe_saml_settings", false, "v202001-1", ""},
+		"Enterprise only in HCP Terraform":        {"tfe_saml_settings", true, "", "tfe_saml_settings is only available in Terraform Enterprise"},
+		"minimum version in HCP Terraform":        {"tfe_workspace_run_task.stages", true, "", ""},
+		"minimum version reached":                 {"tfe_workspace_run_task.stages", false, "v202404-1", ""},
+		"minimum version exceeded":                {"tfe_workspace_run_task.stages", false, "v202410-2", ""},
+		"minimum version not reached":             {"tfe_workspace_run_task.stages", false, "v202402-2", "tfe_workspace_run_task.stages requires HCP Terraform or Terraform Enterprise v202404-1 or later, but the host runs v202402-2"},
+		"unreported version after minimum":        {"tfe_workspace_run_task.stages", false, "", "but the host runs a release earlier than v202208-3"},
+		"unreported version before minimum":       {"tfe_test_capability", false, "", ""},
+		"block minimum version not reached":       {"tfe_team_project_access.workspace_access", false, "v202307-1", "requires HCP Terraform or Terraform Enterprise v202308-1 or later"},
+		"unrecognized version treated as current": {"tfe_workspace_run_task.stages", false, "main", ""},
+	}
+
+	capabilityRegistry["tfe_test_capability"] = capability{minTFEVersion: "v202106-1"}
+	t.Cleanup(func() { delete(capabilityRegistry, "tfe_test_capability") })
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resolver := &staticCapabilityResolver{}
+			resolver.SetIsCloud(testCase.isCloud)
+			resolver.SetRemoteTFEVersion(testCase.tfeVer)
+
+			err := checkCapability(resolver, testCase.key)
+			if testCase.expectError == "" {
+				if err != nil {
+					t.Fatalf("expected %s to be supported, got %v", testCase.key, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+				t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+			}
+		})
+	}
+}
+
+func TestCompareTFEVersions(t *testing.T) {
+	testCases := []struct {
+		a, b   string
+		expect int
+	}{
+		{"v202404-1", "v202404-1", 0},
+		{"v202404-1", "v202404-2", -1},
+		{"v202404-10", "v202404-2", 1},
+		{"v202312-1", "v202401-1", -1},
+		{"main", "v202401-1", 1},
+	}
+
+	for _, testCase := range testCases {
+		actual := compareTFEVersions(testCase.a, testCase.b)
+		if (actual < 0 && testCase.expect >= 0) || (actual > 0 && testCase.expect <= 0) || (actual == 0 && testCase.expect != 0) {
+			t.Errorf("expected comparing %s to %s to give %d, got %d", testCase.a, testCase.b, testCase.expect, actual)
+		}
+	}
+}
+
+func TestRegisteredAttributes(t *testing.T) {
+	if attrs := registeredAttributes("tfe_workspace_run_task"); len(attrs) != 1 || attrs[0] != "stages" {
+		t.Fatalf("expected the stages attribute to be registered, got %v", attrs)
+	}
+	if attrs := registeredAttributes("tfe_stack"); len(attrs) != 0 {
+		t.Fatalf("expected no attributes to be registered, got %v", attrs)
+	}
+}
+
+func TestCapabilityRegistry_keys(t *testing.T) {
+	schemas := testResourceSchemas(t)
+	for key := range capabilityRegistry {
+		resourceType, name, _ := strings.Cut(key, ".")
+		s, ok := schemas[resourceType]
+		if !ok {
+			t.Errorf("%s: no resource type %s", key, resourceType)
+			continue
+		}
+		if name == "" {
+			continue
+		}
+
+		found := false
+		for _, a := range s.Block.Attributes {
+			found = found || a.Name == name
+		}
+		for _, b := range s.Block.BlockTypes {
+			found = found || b.TypeName == name
+		}
+		if !found {
+			t.Errorf("%s: no attribute or block %s in %s", key, name, resourceType)
+		}
+	}
+}
+
+func TestModifyPlanForCapabilities(t *testing.T) {
+	ctx := context.Background()
+
+	stack := &resourceTFEStack{}
+	stackSchema := resource.SchemaResponse{}
+	stack.Schema(ctx, resource.SchemaRequest{}, &stackSchema)
+
+	stages := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "pre_plan"),
+	})
+
+	testCases := map[string]struct {
+		schema       schema.Schema
+		resourceType string
+		attrs        map[string]tftypes.Value
+		tfeVer       string
+		expectError  string
+		expectWarn   string
+	}{
+		"stages on an old release": {
+			schema:       resourceWorkspaceRunTaskSchemaV1,
+			resourceType: "tfe_workspace_run_task",
+			attrs:        map[string]tftypes.Value{"stages": stages},
+			tfeVer:       "v202402-2",
+			expectWarn:   "tfe_workspace_run_task.stages requires HCP Terraform or Terraform Enterprise v202404-1 or later, but the host runs v202402-2",
+		},
+		"stages on a recent release": {
+			schema:       resourceWorkspaceRunTaskSchemaV1,
+			resourceType: "tfe_workspace_run_task",
+			attrs:        map[string]tftypes.Value{"stages": stages},
+			tfeVer:       "v202404-1",
+		},
+		"stages unset on an old release": {
+			schema:       resourceWorkspaceRunTaskSchemaV1,
+			resourceType: "tfe_workspace_run_task",
+			tfeVer:       "v202402-2",
+		},
+		"stack in Enterprise": {
+			schema:       stackSchema.Schema,
+			resourceType: "tfe_stack",
+			tfeVer:       "v202408-1",
+			expectError:  "tfe_stack is only available in HCP Terraform",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := testCapabilitiesObject(ctx, testCase.schema, testCase.attrs)
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: testCase.schema, Raw: config},
+				Plan:   tfsdk.Plan{Schema: testCase.schema, Raw: config},
+				State:  tfsdk.State{Schema: testCase.schema, Raw: tftypes.NewValue(testCase.schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			resolver := &staticCapabilityResolver{}
+			resolver.SetRemoteTFEVersion(testCase.tfeVer)
+			modifyPlanForCapabilities(ctx, resolver, testCase.resourceType, req, resp)
+
+			assertDiagnostic(t, resp.Diagnostics.Errors(), testCase.expectError)
+			assertDiagnostic(t, resp.Diagnostics.Warnings(), testCase.expectWarn)
+		})
+	}
+}
+
+// testCapabilitiesObject returns an object of the schema's type with every
+// attribute null, apart from attrs.
+func testCapabilitiesObject(ctx context.Context, s schema.Schema, attrs map[string]tftypes.Value) tftypes.Value {
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func assertDiagnostic(t *testing.T, diags diag.Diagnostics, expect string) {
+	t.Helper()
+
+	if expect == "" {
+		if len(diags) > 0 {
+			t.Fatalf("expected no diagnostics, got %v", diags)
+		}
+		return
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail(), expect) {
+		t.Fatalf("expected a diagnostic containing %q, got %v", expect, diags)
+	}
+}
@@ -9,12 +9,14 @@
 package provider
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEAgentPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEAgentPoolRead,
+		ReadContext: dataSourceTFEAgentPoolRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -41,19 +43,19 @@ func dataSourceTFEAgentPool() *schema.Resource {
 	}
 }
 
-func dataSourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	pool, err := fetchAgentPool(organization, name, config.Client)
+	pool, err := fetchAgentPool(ctx, organization, name, config.Client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(pool.ID)
@@ -13,12 +13,13 @@ import (
 	"log"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEGHAInstallation() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceGHAInstallationRead,
+		ReadContext: dataSourceGHAInstallationRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -37,8 +38,7 @@ func dataSourceTFEGHAInstallation() *schema.Resource {
 	}
 }
 
-func dataSourceGHAInstallationRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.TODO()
+func dataSourceGHAInstallationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Reading github app installation")
@@ -60,7 +60,7 @@ func dataSourceGHAInstallationRead(d *schema.ResourceData, meta interface{}) err
 	}
 	ghai, err = fetchGithubAppInstallationByNameOrGHID(ctx, config.Client, name, GHInstallationID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*ghai.ID)
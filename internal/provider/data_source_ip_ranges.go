@@ -9,15 +9,16 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEIPRanges() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEIPRangesRead,
+		ReadContext: dataSourceTFEIPRangesRead,
 
 		Schema: map[string]*schema.Schema{
 			"api": {
@@ -44,13 +45,13 @@ func dataSourceTFEIPRanges() *schema.Resource {
 	}
 }
 
-func dataSourceTFEIPRangesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEIPRangesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Reading IP Ranges")
 	ipRanges, err := config.Client.Meta.IPRanges.Read(ctx, "")
 	if err != nil {
-		return fmt.Errorf("Error retrieving IP ranges: %w", err)
+		return diag.Errorf("Error retrieving IP ranges: %v", err)
 	}
 
 	d.SetId("ip-ranges")
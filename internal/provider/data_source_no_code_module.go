@@ -107,6 +107,11 @@ func (d *dataSourceTFENoCodeModule) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
+	resp.Diagnostics.Append(d.config.useDataOrganization(ctx, req.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	options := &tfe.RegistryNoCodeModuleReadOptions{
 		Include: []tfe.RegistryNoCodeModuleIncludeOpt{tfe.RegistryNoCodeIncludeVariableOptions},
 	}
@@ -10,17 +10,17 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceTFEOAuthClient() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOAuthClientRead,
+		ReadContext: dataSourceTFEOAuthClientRead,
 		Schema: map[string]*schema.Schema{
 			"oauth_client_id": {
 				Type:         schema.TypeString,
@@ -94,8 +94,7 @@ func dataSourceTFEOAuthClient() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.TODO()
+func dataSourceTFEOAuthClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var oc *tfe.OAuthClient
@@ -105,13 +104,13 @@ func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) erro
 	case ok:
 		oc, err = config.Client.OAuthClients.Read(ctx, v.(string))
 		if err != nil {
-			return fmt.Errorf("Error retrieving OAuth client: %w", err)
+			return diag.Errorf("Error retrieving OAuth client: %v", err)
 		}
 	default:
 		// search by name or service provider within a specific organization instead
 		organization, err := config.schemaOrDefaultOrganization(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var name string
@@ -127,7 +126,7 @@ func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) erro
 
 		oc, err = fetchOAuthClientByNameOrServiceProvider(ctx, config.Client, organization, name, serviceProvider)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -150,7 +149,7 @@ func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) erro
 	case 1:
 		d.Set("oauth_token_id", oc.OAuthTokens[0].ID)
 	default:
-		return fmt.Errorf("unexpected number of OAuth tokens: %d", len(oc.OAuthTokens))
+		return diag.Errorf("unexpected number of OAuth tokens: %d", len(oc.OAuthTokens))
 	}
 
 	var projectIDs []interface{}
@@ -9,16 +9,17 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEOrganization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationRead,
+		ReadContext: dataSourceTFEOrganizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -84,21 +85,21 @@ func dataSourceTFEOrganization() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	name, err := config.schemaOrDefaultOrganizationKey(d, "name")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Read configuration for Organization: %s", name)
 	org, err := config.Client.Organizations.Read(ctx, name)
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
-			return fmt.Errorf("could not read organization '%s'", name)
+			return diag.Errorf("could not read organization '%s'", name)
 		}
-		return fmt.Errorf("Error retrieving organization: %w", err)
+		return diag.Errorf("Error retrieving organization: %v", err)
 	}
 
 	log.Printf("[DEBUG] Setting Organization Attributes")
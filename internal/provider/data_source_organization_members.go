@@ -9,12 +9,14 @@
 package provider
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEOrganizationMembers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationMembersRead,
+		ReadContext: dataSourceTFEOrganizationMembersRead,
 
 		Schema: map[string]*schema.Schema{
 			"organization": {
@@ -61,17 +63,17 @@ func dataSourceTFEOrganizationMembers() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEOrganizationMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	organizationName, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	members, membersWaiting, err := fetchOrganizationMembers(config.Client, organizationName)
+	members, membersWaiting, err := fetchOrganizationMembers(ctx, config.Client, organizationName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("members", members)
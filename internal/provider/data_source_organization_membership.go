@@ -10,14 +10,14 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEOrganizationMembership() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationMembershipRead,
+		ReadContext: dataSourceTFEOrganizationMembershipRead,
 
 		Schema: map[string]*schema.Schema{
 			"email": {
@@ -51,7 +51,7 @@ func dataSourceTFEOrganizationMembership() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEOrganizationMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the user email and organization.
@@ -61,13 +61,13 @@ func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interf
 
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if orgMemberID == "" {
-		orgMember, err := fetchOrganizationMemberByNameOrEmail(context.Background(), config.Client, organization, username, email)
+		orgMember, err := fetchOrganizationMemberByNameOrEmail(ctx, config.Client, organization, username, email)
 		if err != nil {
-			return fmt.Errorf("could not find organization membership for organization %s: %w", organization, err)
+			return diag.Errorf("could not find organization membership for organization %s: %v", organization, err)
 		}
 
 		d.SetId(orgMember.ID)
@@ -75,5 +75,5 @@ func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interf
 		d.SetId(orgMemberID)
 	}
 
-	return resourceTFEOrganizationMembershipRead(d, meta)
+	return resourceTFEOrganizationMembershipRead(ctx, d, meta)
 }
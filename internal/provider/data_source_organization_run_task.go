@@ -126,7 +126,7 @@ func (d *dataSourceOrganizationRunTask) Read(ctx context.Context, req datasource
 		return
 	}
 
-	task, err := fetchOrganizationRunTask(data.Name.ValueString(), organization, d.config.Client)
+	task, err := fetchOrganizationRunTask(ctx, data.Name.ValueString(), organization, d.config.Client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading Organization Run Task",
 			fmt.Sprintf("Could not read Run Task %q in organization %q, unexpected error: %s", data.Name.String(), organization, err.Error()),
@@ -107,7 +107,7 @@ func (d *dataSourceOrganizationRunTaskGlobalSettings) Read(ctx context.Context,
 		return
 	}
 
-	result := dataModelFromTFEOrganizationRunTaskGlobalSettings(*task)
+	result := dataModelFromTFEOrganizationRunTaskGlobalSettings(ctx, *task)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
@@ -9,15 +9,16 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEOrganizationTags() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationTagsRead,
+		ReadContext: dataSourceTFEOrganizationTagsRead,
 
 		Schema: map[string]*schema.Schema{
 			"organization": {
@@ -50,12 +51,12 @@ func dataSourceTFEOrganizationTags() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationTagsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEOrganizationTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tfeClient := meta.(ConfiguredClient)
 
 	organizationName, err := tfeClient.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tags []map[string]interface{}
@@ -64,7 +65,7 @@ func dataSourceTFEOrganizationTagsRead(d *schema.ResourceData, meta interface{})
 	for {
 		organizationTagsList, err := tfeClient.Client.OrganizationTags.List(ctx, organizationName, &options)
 		if err != nil {
-			return fmt.Errorf("Error retrieving organization tags: %w", err)
+			return diag.Errorf("Error retrieving organization tags: %v", err)
 		}
 
 		for _, orgTag := range organizationTagsList.Items {
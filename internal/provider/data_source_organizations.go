@@ -9,16 +9,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEOrganizations() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationList,
+		ReadContext: dataSourceTFEOrganizationList,
 
 		Schema: map[string]*schema.Schema{
 			"names": {
@@ -41,7 +43,7 @@ func dataSourceTFEOrganizations() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationList(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEOrganizationList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var names []string
@@ -49,13 +51,13 @@ func dataSourceTFEOrganizationList(d *schema.ResourceData, meta interface{}) err
 	var err error
 
 	if isAdmin(d) {
-		names, ids, err = adminOrgsPopulateFields(config.Client)
+		names, ids, err = adminOrgsPopulateFields(ctx, config.Client)
 	} else {
-		names, ids, err = orgsPopulateFields(config.Client)
+		names, ids, err = orgsPopulateFields(ctx, config.Client)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Setting Organizations Attributes")
@@ -66,7 +68,7 @@ func dataSourceTFEOrganizationList(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func adminOrgsPopulateFields(client *tfe.Client) ([]string, map[string]string, error) {
+func adminOrgsPopulateFields(ctx context.Context, client *tfe.Client) ([]string, map[string]string, error) {
 	names := []string{}
 	ids := map[string]string{}
 	log.Printf("[DEBUG] Listing all organizations (admin)")
@@ -98,7 +100,7 @@ func adminOrgsPopulateFields(client *tfe.Client) ([]string, map[string]string, e
 	return names, ids, nil
 }
 
-func orgsPopulateFields(client *tfe.Client) ([]string, map[string]string, error) {
+func orgsPopulateFields(ctx context.Context, client *tfe.Client) ([]string, map[string]string, error) {
 	names := []string{}
 	ids := map[string]string{}
 	log.Printf("[DEBUG] Listing all organizations (non-admin)")
@@ -21,14 +21,12 @@ import (
 )
 
 type dataSourceOutputs struct {
-	tfeClient    *tfe.Client
-	organization string
+	config ConfiguredClient
 }
 
 func newDataSourceOutputs(config ConfiguredClient) tfprotov5.DataSourceServer {
 	return dataSourceOutputs{
-		tfeClient:    config.Client,
-		organization: config.Organization,
+		config: config,
 	}
 }
 
@@ -47,6 +45,14 @@ func (d dataSourceOutputs) ReadDataSource(ctx context.Context, req *tfprotov5.Re
 		return resp, nil
 	}
 
+	if err := d.config.useOrganization(orgName); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Error reading remote state output",
+			Detail:   fmt.Sprintf("Error reading remote state output: %v", err),
+		})
+		return resp, nil
+	}
 	remoteStateOutput, err := d.readStateOutput(ctx, orgName, wsName)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -138,10 +144,10 @@ func (d dataSourceOutputs) readConfigValues(req *tfprotov5.ReadDataSourceRequest
 
 	err = valMap["organization"].As(&orgName)
 	if err != nil || orgName == "" {
-		if d.organization == "" {
+		if d.config.Organization == "" {
 			return "", "", errMissingOrganization
 		}
-		orgName = d.organization
+		orgName = d.config.Organization
 	}
 
 	if valMap["workspace"].IsNull() {
@@ -170,7 +176,7 @@ func (d dataSourceOutputs) readStateOutput(ctx context.Context, orgName, wsName
 	opts := &tfe.WorkspaceReadOptions{
 		Include: []tfe.WSIncludeOpt{tfe.WSOutputs},
 	}
-	ws, err := d.tfeClient.Workspaces.ReadWithOptions(ctx, orgName, wsName, opts)
+	ws, err := d.config.Client.Workspaces.ReadWithOptions(ctx, orgName, wsName, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error reading workspace: %w", err)
 	}
@@ -181,7 +187,7 @@ func (d dataSourceOutputs) readStateOutput(ctx context.Context, orgName, wsName
 
 	for _, op := range ws.Outputs {
 		if op.Sensitive {
-			sensitiveOutput, err := d.tfeClient.StateVersionOutputs.Read(ctx, op.ID)
+			sensitiveOutput, err := d.config.Client.StateVersionOutputs.Read(ctx, op.ID)
 			if err != nil {
 				return nil, fmt.Errorf("could not read sensitive output: %w", err)
 			}
@@ -9,16 +9,17 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEPolicySet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEPolicySetRead,
+		ReadContext: dataSourceTFEPolicySetRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -130,13 +131,13 @@ func dataSourceTFEPolicySet() *schema.Resource {
 	}
 }
 
-func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	listOptions := tfe.PolicySetListOptions{}
@@ -146,9 +147,9 @@ func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error
 
 		if err != nil {
 			if errors.Is(err, tfe.ErrResourceNotFound) {
-				return fmt.Errorf("could not find policy set %s/%s", organization, name)
+				return diag.Errorf("could not find policy set %s/%s", organization, name)
 			}
-			return fmt.Errorf("Error retrieving policy set %s: %w", name, err)
+			return diag.Errorf("Error retrieving policy set %s: %v", name, err)
 		}
 
 		for _, policySet := range policySetList.Items {
@@ -225,5 +226,5 @@ func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error
 		// Update the page number to get the next page.
 		listOptions.PageNumber = policySetList.NextPage
 	}
-	return fmt.Errorf("could not find policy set %s/%s", organization, name)
+	return diag.Errorf("could not find policy set %s/%s", organization, name)
 }
@@ -10,6 +10,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -17,12 +18,13 @@ import (
 	"os"
 
 	slug "github.com/hashicorp/go-slug"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFESlug() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFESlugRead,
+		ReadContext: dataSourceTFESlugRead,
 
 		Schema: map[string]*schema.Schema{
 			"source_path": {
@@ -33,13 +35,13 @@ func dataSourceTFESlug() *schema.Resource {
 	}
 }
 
-func dataSourceTFESlugRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFESlugRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourcePath := d.Get("source_path").(string)
 
 	log.Printf("[DEBUG] Hashing the source path files: %s", sourcePath)
 	chksum, err := hashPolicies(sourcePath)
 	if err != nil {
-		return fmt.Errorf("Error generating the checksum for the source path files: %w", err)
+		return diag.Errorf("Error generating the checksum for the source path files: %v", err)
 	}
 	d.SetId(chksum)
 
@@ -9,15 +9,16 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFESSHKey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFESSHKeyRead,
+		ReadContext: dataSourceTFESSHKeyRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -33,14 +34,14 @@ func dataSourceTFESSHKey() *schema.Resource {
 	}
 }
 
-func dataSourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFESSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create an options struct.
@@ -49,7 +50,7 @@ func dataSourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 	for {
 		l, err := config.Client.SSHKeys.List(ctx, organization, options)
 		if err != nil {
-			return fmt.Errorf("Error retrieving SSH keys: %w", err)
+			return diag.Errorf("Error retrieving SSH keys: %v", err)
 		}
 
 		for _, k := range l.Items {
@@ -68,5 +69,5 @@ func dataSourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 		options.PageNumber = l.NextPage
 	}
 
-	return fmt.Errorf("could not find SSH key %s/%s", organization, name)
+	return diag.Errorf("could not find SSH key %s/%s", organization, name)
 }
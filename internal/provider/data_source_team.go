@@ -9,15 +9,16 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFETeam() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFETeamRead,
+		ReadContext: dataSourceTFETeamRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -37,31 +38,31 @@ func dataSourceTFETeam() *schema.Resource {
 	}
 }
 
-func dataSourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFETeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tl, err := config.Client.Teams.List(ctx, organization, &tfe.TeamListOptions{
 		Names: []string{name},
 	})
 	if err != nil {
-		return fmt.Errorf("Error retrieving teams: %w", err)
+		return diag.Errorf("Error retrieving teams: %v", err)
 	}
 
 	switch len(tl.Items) {
 	case 0:
-		return fmt.Errorf("could not find team %s/%s", organization, name)
+		return diag.Errorf("could not find team %s/%s", organization, name)
 	case 1:
 		// We check this just in case a user's TFE instance only has one team
 		// and doesn't support the filter query param
 		if tl.Items[0].Name != name {
-			return fmt.Errorf("could not find team %s/%s", organization, name)
+			return diag.Errorf("could not find team %s/%s", organization, name)
 		}
 
 		d.SetId(tl.Items[0].ID)
@@ -88,10 +89,10 @@ func dataSourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 
 			tl, err = config.Client.Teams.List(ctx, organization, options)
 			if err != nil {
-				return fmt.Errorf("Error retrieving teams: %w", err)
+				return diag.Errorf("Error retrieving teams: %v", err)
 			}
 		}
 	}
 
-	return fmt.Errorf("could not find team %s/%s", organization, name)
+	return diag.Errorf("could not find team %s/%s", organization, name)
 }
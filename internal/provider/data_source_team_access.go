@@ -9,15 +9,16 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFETeamAccess() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFETeamAccessRead,
+		ReadContext: dataSourceTFETeamAccessRead,
 
 		Schema: map[string]*schema.Schema{
 			"access": {
@@ -76,7 +77,7 @@ func dataSourceTFETeamAccess() *schema.Resource {
 	}
 }
 
-func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFETeamAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID.
@@ -86,8 +87,8 @@ func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error
 	workspaceID := d.Get("workspace_id").(string)
 	ws, err := config.Client.Workspaces.ReadByID(ctx, workspaceID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error retrieving workspace %s: %w", workspaceID, err)
+		return diag.Errorf(
+			"Error retrieving workspace %s: %v", workspaceID, err)
 	}
 
 	// Create an options struct.
@@ -98,13 +99,13 @@ func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error
 	for {
 		l, err := config.Client.TeamAccess.List(ctx, options)
 		if err != nil {
-			return fmt.Errorf("Error retrieving team access list: %w", err)
+			return diag.Errorf("Error retrieving team access list: %v", err)
 		}
 
 		for _, ta := range l.Items {
 			if ta.Team.ID == teamID {
 				d.SetId(ta.ID)
-				return resourceTFETeamAccessRead(d, meta)
+				return resourceTFETeamAccessRead(ctx, d, meta)
 			}
 		}
 
@@ -117,5 +118,5 @@ func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error
 		options.PageNumber = l.NextPage
 	}
 
-	return fmt.Errorf("could not find team access for %s and workspace %s", teamID, ws.Name)
+	return diag.Errorf("could not find team access for %s and workspace %s", teamID, ws.Name)
 }
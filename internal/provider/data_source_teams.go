@@ -9,15 +9,16 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFETeams() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFETeamsRead,
+		ReadContext: dataSourceTFETeamsRead,
 
 		Schema: map[string]*schema.Schema{
 			"organization": {
@@ -39,20 +40,20 @@ func dataSourceTFETeams() *schema.Resource {
 	}
 }
 
-func dataSourceTFETeamsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFETeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	teams, err := config.Client.Teams.List(ctx, organization, &tfe.TeamListOptions{})
 	if err != nil {
-		return fmt.Errorf("Error retrieving teams: %w", err)
+		return diag.Errorf("Error retrieving teams: %v", err)
 	}
 
 	if len(teams.Items) == 0 {
-		return fmt.Errorf("could not find teams in %q", organization)
+		return diag.Errorf("could not find teams in %q", organization)
 	}
 
 	options := &tfe.TeamListOptions{}
@@ -72,7 +73,7 @@ func dataSourceTFETeamsRead(d *schema.ResourceData, meta interface{}) error {
 
 		teams, err = config.Client.Teams.List(ctx, organization, options)
 		if err != nil {
-			return fmt.Errorf("Error retrieving teams: %w", err)
+			return diag.Errorf("Error retrieving teams: %v", err)
 		}
 	}
 
@@ -9,15 +9,16 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEVariableSet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEVariableSetRead,
+		ReadContext: dataSourceTFEVariableSetRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -75,14 +76,14 @@ func dataSourceTFEVariableSet() *schema.Resource {
 	}
 }
 
-func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create an options struct.
@@ -94,9 +95,9 @@ func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) erro
 		l, err := config.Client.VariableSets.List(ctx, organization, &options)
 		if err != nil {
 			if err == tfe.ErrResourceNotFound {
-				return fmt.Errorf("could not find variable set%s/%s", organization, name)
+				return diag.Errorf("could not find variable set%s/%s", organization, name)
 			}
-			return fmt.Errorf("Error retrieving variable set: %w", err)
+			return diag.Errorf("Error retrieving variable set: %v", err)
 		}
 
 		for _, vs := range l.Items {
@@ -117,7 +118,7 @@ func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) erro
 
 				vs, err = config.Client.VariableSets.Read(ctx, vs.ID, &readOptions)
 				if err != nil {
-					return fmt.Errorf("Error retrieving variable set relations: %w", err)
+					return diag.Errorf("Error retrieving variable set relations: %v", err)
 				}
 
 				var workspaces []interface{}
@@ -152,5 +153,5 @@ func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) erro
 		options.PageNumber = l.NextPage
 	}
 
-	return fmt.Errorf("could not find variable set %s/%s", organization, name)
+	return diag.Errorf("could not find variable set %s/%s", organization, name)
 }
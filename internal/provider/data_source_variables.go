@@ -9,10 +9,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -45,7 +47,7 @@ func dataSourceTFEWorkspaceVariables() *schema.Resource {
 		},
 	}
 	return &schema.Resource{
-		Read: dataSourceVariableRead,
+		ReadContext: dataSourceVariableRead,
 
 		Schema: map[string]*schema.Schema{
 			"env": {
@@ -83,11 +85,11 @@ func dataSourceTFEWorkspaceVariables() *schema.Resource {
 	}
 }
 
-func dataSourceVariableRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Switch to variable set variable logic
 	_, variableSetIDProvided := d.GetOk("variable_set_id")
 	if variableSetIDProvided {
-		return dataSourceVariableSetVariableRead(d, meta)
+		return diag.FromErr(dataSourceVariableSetVariableRead(ctx, d, meta))
 	}
 
 	config := meta.(ConfiguredClient)
@@ -105,7 +107,7 @@ func dataSourceVariableRead(d *schema.ResourceData, meta interface{}) error {
 	for {
 		variableList, err := config.Client.Variables.List(ctx, workspaceID, options)
 		if err != nil {
-			return fmt.Errorf("Error retrieving variable list: %w", err)
+			return diag.Errorf("Error retrieving variable list: %v", err)
 		}
 		terraformVars := make([]interface{}, 0)
 		envVars := make([]interface{}, 0)
@@ -143,7 +145,7 @@ func dataSourceVariableRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func dataSourceVariableSetVariableRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceVariableSetVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(ConfiguredClient)
 
 	// Get the id.
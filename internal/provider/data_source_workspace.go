@@ -9,17 +9,18 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/url"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEWorkspaceRead,
+		ReadContext: dataSourceTFEWorkspaceRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -222,23 +223,23 @@ func dataSourceTFEWorkspace() *schema.Resource {
 	}
 }
 
-func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Read configuration of workspace: %s", name)
 	workspace, err := config.Client.Workspaces.Read(ctx, organization, name)
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
-			return fmt.Errorf("could not find workspace %s/%s", organization, name)
+			return diag.Errorf("could not find workspace %s/%s", organization, name)
 		}
-		return fmt.Errorf("Error retrieving workspace: %w", err)
+		return diag.Errorf("Error retrieving workspace: %v", err)
 	}
 	// Update the config.
 	d.Set("allow_destroy_plan", workspace.AllowDestroyPlan)
@@ -252,7 +253,7 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 
 	autoDestroyAt, err := flattenAutoDestroyAt(workspace.AutoDestroyAt)
 	if err != nil {
-		return fmt.Errorf("Error flattening auto destroy during read: %w", err)
+		return diag.Errorf("Error flattening auto destroy during read: %v", err)
 	}
 	d.Set("auto_destroy_at", autoDestroyAt)
 
@@ -260,7 +261,7 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	if workspace.AutoDestroyActivityDuration.IsSpecified() {
 		autoDestroyDuration, err = workspace.AutoDestroyActivityDuration.Get()
 		if err != nil {
-			return fmt.Errorf("Error reading auto destroy activity duration: %w", err)
+			return diag.Errorf("Error reading auto destroy activity duration: %v", err)
 		}
 	}
 	d.Set("auto_destroy_activity_duration", autoDestroyDuration)
@@ -299,14 +300,14 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	globalRemoteState := workspace.GlobalRemoteState
 	if globalRemoteState {
 		if err := d.Set("remote_state_consumer_ids", []string{}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		legacyGlobalState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(workspace.ID, config.Client)
+		legacyGlobalState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ctx, workspace.ID, config.Client)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error reading remote state consumers for workspace %s: %w", workspace.ID, err)
+			return diag.Errorf(
+				"Error reading remote state consumers for workspace %s: %v", workspace.ID, err)
 		}
 
 		if legacyGlobalState {
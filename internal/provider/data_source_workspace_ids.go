@@ -9,16 +9,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEWorkspaceIDs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEWorkspaceIDsRead,
+		ReadContext: dataSourceTFEWorkspaceIDsRead,
 
 		Schema: map[string]*schema.Schema{
 			"names": {
@@ -90,13 +92,13 @@ func includedByName(names map[string]bool, workspaceName string) bool {
 	return false
 }
 
-func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEWorkspaceIDsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a map with all the names we are looking for.
@@ -153,7 +155,7 @@ func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) err
 	for {
 		wl, err := config.Client.Workspaces.List(ctx, organization, options)
 		if err != nil {
-			return fmt.Errorf("Error retrieving workspaces: %w", err)
+			return diag.Errorf("Error retrieving workspaces: %v", err)
 		}
 
 		for _, w := range wl.Items {
@@ -131,7 +131,7 @@ func (d *dataSourceWorkspaceRunTask) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	result := modelFromTFEWorkspaceRunTask(wstask)
+	result := modelFromTFEWorkspaceRunTask(ctx, wstask)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// deletionProtection lists the resources that must never be destroyed: every
+// resource of resourceTypes, or only the ones whose name matches one of
+// namePatterns when it isn't empty.
+type deletionProtection struct {
+	resourceTypes map[string]bool
+	namePatterns  []string
+}
+
+// protects reports whether the resource of the given type and name, which is
+// empty for resources without a name, is protected.
+func (p *deletionProtection) protects(typeName, name string) bool {
+	if p == nil || !p.resourceTypes[typeName] {
+		return false
+	}
+	if len(p.namePatterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range p.namePatterns {
+		if matched, _ := path.Match(pattern, name); matched && name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *deletionProtection) validate() error {
+	for _, pattern := range p.namePatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid name pattern %q in deletion_protection: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// deletionProtectionServer fails plans that would destroy or replace a
+// resource matching the deletion_protection block, whatever the lifecycle
+// settings of the resource. It wraps the muxed server, as it applies to the
+// resources of every server.
+type deletionProtectionServer struct {
+	tfprotov5.ProviderServer
+
+	mu         sync.RWMutex
+	protection *deletionProtection
+
+	typesOnce     sync.Once
+	resourceTypes map[string]tftypes.Type
+}
+
+// WithDeletionProtection wraps a provider server so that plans destroying a
+// resource protected by deletion_protection fail.
+func WithDeletionProtection(server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &deletionProtectionServer{ProviderServer: server()}
+	}
+}
+
+func (s *deletionProtectionServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	// Invalid configurations are reported by the servers configuring the
+	// client, so they are ignored here.
+	meta, err := retrieveProviderMeta(req)
+	if err != nil {
+		return resp, nil
+	}
+
+	if meta.deletionProtection != nil {
+		if err := meta.deletionProtection.validate(); err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Invalid deletion_protection",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+	}
+
+	s.mu.Lock()
+	s.protection = meta.deletionProtection
+	s.mu.Unlock()
+
+	return resp, nil
+}
+
+func (s *deletionProtectionServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	s.mu.RLock()
+	protection := s.protection
+	s.mu.RUnlock()
+	if protection == nil {
+		return resp, nil
+	}
+
+	action, name, err := s.plannedDeletion(ctx, req, resp)
+	if err != nil {
+		log.Printf("[WARN] Failed to determine the planned change of %s: %v", req.TypeName, err)
+		return resp, nil
+	}
+	if action == "" || !protection.protects(req.TypeName, name) {
+		return resp, nil
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Resource is protected from deletion",
+		Detail: fmt.Sprintf("This plan would %s this %s, which matches the deletion_protection block of the provider configuration. "+
+			"Remove it from deletion_protection to allow it to be destroyed.", action, req.TypeName),
+	})
+
+	return resp, nil
+}
+
+// plannedDeletion returns "delete" or "replace" for a plan that destroys the
+// resource, or "" when it doesn't, along with the name attribute of the
+// resource before the change, if it has one.
+func (s *deletionProtectionServer) plannedDeletion(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest, resp *tfprotov5.PlanResourceChangeResponse) (string, string, error) {
+	resourceType, err := s.resourceType(ctx, req.TypeName)
+	if err != nil {
+		return "", "", err
+	}
+
+	prior, err := dynamicValue(req.PriorState, resourceType)
+	if err != nil {
+		return "", "", err
+	}
+	planned, err := dynamicValue(resp.PlannedState, resourceType)
+	if err != nil {
+		return "", "", err
+	}
+	if prior.IsNull() {
+		return "", "", nil
+	}
+
+	var name string
+	var attrs map[string]tftypes.Value
+	if err := prior.As(&attrs); err == nil {
+		if v, ok := attrs["name"]; ok && v.IsKnown() && !v.IsNull() && v.Type().Is(tftypes.String) {
+			_ = v.As(&name)
+		}
+	}
+
+	switch {
+	case planned.IsNull():
+		return "delete", name, nil
+	case len(resp.RequiresReplace) > 0:
+		return "replace", name, nil
+	default:
+		return "", name, nil
+	}
+}
+
+// resourceType returns the type of the state of a resource, from the schemas
+// of the wrapped server.
+func (s *deletionProtectionServer) resourceType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	s.typesOnce.Do(func() {
+		s.resourceTypes = map[string]tftypes.Type{}
+
+		resp, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil || resp == nil {
+			return
+		}
+		for name, schema := range resp.ResourceSchemas {
+			s.resourceTypes[name] = schema.ValueType()
+		}
+	})
+
+	resourceType, ok := s.resourceTypes[typeName]
+	if !ok {
+		return nil, errUnsupportedResource(typeName)
+	}
+	return resourceType, nil
+}
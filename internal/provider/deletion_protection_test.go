@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testReplacePlanServer is a testPlanServer whose changes may require the
+// resource to be replaced.
+type testReplacePlanServer struct {
+	testPlanServer
+
+	replace bool
+}
+
+func (s testReplacePlanServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.testPlanServer.PlanResourceChange(ctx, req)
+	if err == nil && s.replace {
+		resp.RequiresReplace = []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("name")}
+	}
+	return resp, err
+}
+
+// testPlanState returns the state of a tfe_test resource, which is null when
+// name is nil.
+func testPlanState(t *testing.T, name *string) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	typ := testPlanSchema.ValueType()
+	val := tftypes.NewValue(typ, nil)
+	if name != nil {
+		val = tftypes.NewValue(typ, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, *name)})
+	}
+	v, err := tfprotov5.NewDynamicValue(typ, val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &v
+}
+
+func TestDeletionProtectionServer_planResourceChange(t *testing.T) {
+	deletionProtection := func(namePatterns ...string) tftypes.Value {
+		blockType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"resource_types": tftypes.Set{ElementType: tftypes.String},
+			"name_patterns":  tftypes.List{ElementType: tftypes.String},
+		}}
+
+		patterns := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+		if len(namePatterns) > 0 {
+			var values []tftypes.Value
+			for _, p := range namePatterns {
+				values = append(values, tftypes.NewValue(tftypes.String, p))
+			}
+			patterns = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+		}
+
+		return tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+			tftypes.NewValue(blockType, map[string]tftypes.Value{
+				"resource_types": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "tfe_test"),
+				}),
+				"name_patterns": patterns,
+			}),
+		})
+	}
+	prod, dev, renamed := "prod-app", "dev-app", "prod-app-2"
+
+	cases := map[string]struct {
+		protection tftypes.Value
+		prior      *string
+		proposed   *string
+		replace    bool
+		expectErr  bool
+	}{
+		"delete":                   {protection: deletionProtection(), prior: &prod, expectErr: true},
+		"replace":                  {protection: deletionProtection(), prior: &prod, proposed: &renamed, replace: true, expectErr: true},
+		"update":                   {protection: deletionProtection(), prior: &prod, proposed: &renamed},
+		"create":                   {protection: deletionProtection(), proposed: &prod},
+		"delete matching name":     {protection: deletionProtection("prod-*"), prior: &prod, expectErr: true},
+		"delete not matching name": {protection: deletionProtection("prod-*"), prior: &dev},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := WithDeletionProtection(func() tfprotov5.ProviderServer { return testReplacePlanServer{replace: tc.replace} })()
+
+			_, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+				Config: testProviderConfig(t, map[string]tftypes.Value{
+					"deletion_protection": tc.protection,
+				}),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "tfe_test",
+				PriorState:       testPlanState(t, tc.prior),
+				ProposedNewState: testPlanState(t, tc.proposed),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := len(resp.Diagnostics) > 0; got != tc.expectErr {
+				t.Fatalf("expected an error diagnostic: %t, got %v", tc.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDeletionProtectionServer_invalidNamePattern(t *testing.T) {
+	server := WithDeletionProtection(func() tfprotov5.ProviderServer { return testPlanServer{} })()
+
+	blockType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"resource_types": tftypes.Set{ElementType: tftypes.String},
+		"name_patterns":  tftypes.List{ElementType: tftypes.String},
+	}}
+	resp, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+		Config: testProviderConfig(t, map[string]tftypes.Value{
+			"deletion_protection": tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+				tftypes.NewValue(blockType, map[string]tftypes.Value{
+					"resource_types": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "tfe_test"),
+					}),
+					"name_patterns": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "prod-["),
+					}),
+				}),
+			}),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Diagnostics) == 0 {
+		t.Fatal("expected an error diagnostic for an invalid name pattern")
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type ephemeralAgentToken struct {
+	config ConfiguredClient
+}
+
+var _ ephemeral.EphemeralResource = &ephemeralAgentToken{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralAgentToken{}
+var _ ephemeral.EphemeralResourceWithClose = &ephemeralAgentToken{}
+
+func NewAgentTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralAgentToken{}
+}
+
+type modelTFEAgentTokenEphemeral struct {
+	AgentPoolID types.String `tfsdk:"agent_pool_id"`
+	Description types.String `tfsdk:"description"`
+	Token       types.String `tfsdk:"token"`
+}
+
+// privateAgentToken is the private data of an open tfe_agent_token, which
+// identifies the token to revoke when it is closed.
+type privateAgentToken struct {
+	ID string `json:"id"`
+}
+
+func (r *ephemeralAgentToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_token"
+}
+
+func (r *ephemeralAgentToken) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ephemeral resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+func (r *ephemeralAgentToken) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates an agent token that is revoked once Terraform no longer needs it, and is never stored in state.",
+		Attributes: map[string]schema.Attribute{
+			"agent_pool_id": schema.StringAttribute{
+				Description: "ID of the agent pool.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the agent token.",
+				Required:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The generated token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralAgentToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data modelTFEAgentTokenEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentPoolID := data.AgentPoolID.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Create ephemeral token for agent pool: %s", agentPoolID))
+	token, err := r.config.Client.AgentTokens.Create(ctx, agentPoolID, tfe.AgentTokenCreateOptions{
+		Description: data.Description.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create agent token", fmt.Sprintf("error creating agent token for agent pool %s: %s", agentPoolID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateToken(ctx, resp.Private, privateAgentToken{ID: token.ID})...)
+
+	data.Token = types.StringValue(token.Token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ephemeralAgentToken) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	var private privateAgentToken
+	found, diags := getPrivateToken(ctx, req.Private, &private)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Revoke ephemeral agent token: %s", private.ID))
+	err := r.config.Client.AgentTokens.Delete(ctx, private.ID)
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		resp.Diagnostics.AddError("Unable to revoke agent token", fmt.Sprintf("error revoking agent token %s: %s", private.ID, err))
+	}
+}
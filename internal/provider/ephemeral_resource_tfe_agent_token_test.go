@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEphemeralAgentToken_openClose(t *testing.T) {
+	api := newTestEphemeralTokenAPI(t, map[string]string{
+		"POST /api/v2/agent-pools/apool-123/authentication-tokens": `{"data": {"id": "at-123", "type": "authentication-tokens", "attributes": {"token": "secret", "description": "ci"}}}`,
+	})
+
+	token := api.openClose(t, "tfe_agent_token", map[string]tftypes.Value{
+		"agent_pool_id": tftypes.NewValue(tftypes.String, "apool-123"),
+		"description":   tftypes.NewValue(tftypes.String, "ci"),
+	})
+	if token != "secret" {
+		t.Fatalf("Expected the token to be %q, got %q", "secret", token)
+	}
+
+	api.assertRequests(t,
+		"POST /api/v2/agent-pools/apool-123/authentication-tokens",
+		"DELETE /api/v2/authentication-tokens/at-123",
+	)
+}
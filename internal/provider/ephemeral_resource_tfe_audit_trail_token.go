@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type ephemeralAuditTrailToken struct {
+	config ConfiguredClient
+}
+
+var _ ephemeral.EphemeralResource = &ephemeralAuditTrailToken{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralAuditTrailToken{}
+var _ ephemeral.EphemeralResourceWithClose = &ephemeralAuditTrailToken{}
+
+func NewAuditTrailTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralAuditTrailToken{}
+}
+
+type modelTFEAuditTrailTokenEphemeral struct {
+	Organization    types.String      `tfsdk:"organization"`
+	ExpiredAt       timetypes.RFC3339 `tfsdk:"expired_at"`
+	ForceRegenerate types.Bool        `tfsdk:"force_regenerate"`
+	Token           types.String      `tfsdk:"token"`
+}
+
+// privateAuditTrailToken is the private data of an open
+// tfe_audit_trail_token, which identifies the token to revoke when it is
+// closed.
+type privateAuditTrailToken struct {
+	Organization string `json:"organization"`
+}
+
+func (r *ephemeralAuditTrailToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_trail_token"
+}
+
+func (r *ephemeralAuditTrailToken) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ephemeral resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+func (r *ephemeralAuditTrailToken) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates an audit trail token that is revoked once Terraform no longer needs it, and is never stored in state. An organization has a single audit trail token, so any existing audit trail token of the organization is replaced.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization. If omitted, organization must be defined in the provider config.",
+				Optional:    true,
+			},
+			"expired_at": schema.StringAttribute{
+				Description: "The time when the audit trail token will expire. This must be a valid ISO8601 timestamp.",
+				CustomType:  timetypes.RFC3339Type{},
+				Optional:    true,
+			},
+			"force_regenerate": schema.BoolAttribute{
+				Description: "When set to true, an existing audit trail token of the organization is replaced rather than failing.",
+				Optional:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The generated token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralAuditTrailToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data modelTFEAuditTrailTokenEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var organization string
+	resp.Diagnostics.Append(r.config.dataOrDefaultOrganization(ctx, req.Config, &organization)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenType := tfe.AuditTrailToken
+
+	tflog.Debug(ctx, fmt.Sprintf("Check if an audit trail token already exists for organization: %s", organization))
+	_, err := r.config.Client.OrganizationTokens.ReadWithOptions(ctx, organization, tfe.OrganizationTokenReadOptions{TokenType: &tokenType})
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		resp.Diagnostics.AddError("Error checking if an audit trail token exists", fmt.Sprintf("error checking if an audit trail token exists for organization %s: %s", organization, err))
+		return
+	}
+	if err == nil {
+		if !data.ForceRegenerate.ValueBool() {
+			resp.Diagnostics.AddError("An audit trail token already exists", fmt.Sprintf("an audit trail token already exists for organization: %s", organization))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Regenerating existing audit trail token for organization: %s", organization))
+	}
+
+	expiry, err := tokenExpiry(data.ExpiredAt)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expired_at"), "Invalid date", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create ephemeral audit trail token for organization: %s", organization))
+	token, err := r.config.Client.OrganizationTokens.CreateWithOptions(ctx, organization, tfe.OrganizationTokenCreateOptions{
+		ExpiredAt: expiry,
+		TokenType: &tokenType,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create audit trail token", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateToken(ctx, resp.Private, privateAuditTrailToken{Organization: organization})...)
+
+	data.Organization = types.StringValue(organization)
+	data.Token = types.StringValue(token.Token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ephemeralAuditTrailToken) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	var private privateAuditTrailToken
+	found, diags := getPrivateToken(ctx, req.Private, &private)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		return
+	}
+	if err := r.config.useOrganization(private.Organization); err != nil {
+		resp.Diagnostics.AddError("Unable to revoke audit trail token", err.Error())
+		return
+	}
+
+	tokenType := tfe.AuditTrailToken
+
+	tflog.Debug(ctx, fmt.Sprintf("Revoke ephemeral audit trail token of organization: %s", private.Organization))
+	err := r.config.Client.OrganizationTokens.DeleteWithOptions(ctx, private.Organization, tfe.OrganizationTokenDeleteOptions{TokenType: &tokenType})
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		resp.Diagnostics.AddError("Unable to revoke audit trail token", fmt.Sprintf("error revoking the audit trail token of organization %s: %s", private.Organization, err))
+	}
+}
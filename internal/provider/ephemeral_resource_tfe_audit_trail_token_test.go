@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEphemeralAuditTrailToken_openClose(t *testing.T) {
+	api := newTestEphemeralTokenAPI(t, map[string]string{
+		"POST /api/v2/organizations/hashicorp/authentication-token": `{"data": {"id": "at-123", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`,
+	})
+
+	token := api.openClose(t, "tfe_audit_trail_token", map[string]tftypes.Value{
+		"organization": tftypes.NewValue(tftypes.String, "hashicorp"),
+	})
+	if token != "secret" {
+		t.Fatalf("Expected the token to be %q, got %q", "secret", token)
+	}
+
+	api.assertRequests(t,
+		"GET /api/v2/organizations/hashicorp/authentication-token",
+		"POST /api/v2/organizations/hashicorp/authentication-token",
+		"DELETE /api/v2/organizations/hashicorp/authentication-token",
+	)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEphemeralOrganizationToken_openClose(t *testing.T) {
+	api := newTestEphemeralTokenAPI(t, map[string]string{
+		"POST /api/v2/organizations/hashicorp/authentication-token": `{"data": {"id": "at-123", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`,
+	})
+
+	// The organization defaults to the one of the provider.
+	token := api.openClose(t, "tfe_organization_token", map[string]tftypes.Value{
+		"expired_at": tftypes.NewValue(tftypes.String, "2099-01-01T00:00:00Z"),
+	})
+	if token != "secret" {
+		t.Fatalf("Expected the token to be %q, got %q", "secret", token)
+	}
+
+	api.assertRequests(t,
+		"GET /api/v2/organizations/hashicorp/authentication-token",
+		"POST /api/v2/organizations/hashicorp/authentication-token",
+		"DELETE /api/v2/organizations/hashicorp/authentication-token",
+	)
+}
+
+func TestEphemeralOrganizationToken_invalidExpiry(t *testing.T) {
+	api := newTestEphemeralTokenAPI(t, nil)
+
+	resp := api.open(t, "tfe_organization_token", map[string]tftypes.Value{
+		"organization": tftypes.NewValue(tftypes.String, "hashicorp"),
+		"expired_at":   tftypes.NewValue(tftypes.String, "tomorrow"),
+	})
+	if len(resp.Diagnostics) == 0 {
+		t.Fatal("Expected an invalid expired_at to fail")
+	}
+
+	for _, request := range api.requests {
+		if request[:4] == "POST" {
+			t.Fatalf("Expected no token to be created, got %v", api.requests)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type ephemeralTeamToken struct {
+	config ConfiguredClient
+}
+
+var _ ephemeral.EphemeralResource = &ephemeralTeamToken{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralTeamToken{}
+var _ ephemeral.EphemeralResourceWithClose = &ephemeralTeamToken{}
+
+func NewTeamTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralTeamToken{}
+}
+
+type modelTFETeamTokenEphemeral struct {
+	TeamID          types.String      `tfsdk:"team_id"`
+	ExpiredAt       timetypes.RFC3339 `tfsdk:"expired_at"`
+	ForceRegenerate types.Bool        `tfsdk:"force_regenerate"`
+	Token           types.String      `tfsdk:"token"`
+}
+
+// privateTeamToken is the private data of an open tfe_team_token, which
+// identifies the token to revoke when it is closed.
+type privateTeamToken struct {
+	TeamID string `json:"team_id"`
+}
+
+func (r *ephemeralTeamToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_token"
+}
+
+func (r *ephemeralTeamToken) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ephemeral resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+func (r *ephemeralTeamToken) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a team token that is revoked once Terraform no longer needs it, and is never stored in state. A team has a single token, so any existing token of the team is replaced.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description: "ID of the team.",
+				Required:    true,
+			},
+			"expired_at": schema.StringAttribute{
+				Description: "The time when the team token will expire. This must be a valid ISO8601 timestamp.",
+				CustomType:  timetypes.RFC3339Type{},
+				Optional:    true,
+			},
+			"force_regenerate": schema.BoolAttribute{
+				Description: "When set to true, an existing token of the team is replaced rather than failing.",
+				Optional:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The generated token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralTeamToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data modelTFETeamTokenEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := data.TeamID.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Check if a token already exists for team: %s", teamID))
+	_, err := r.config.Client.TeamTokens.Read(ctx, teamID)
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		resp.Diagnostics.AddError("Error checking if a team token exists", fmt.Sprintf("error checking if a token exists for team %s: %s", teamID, err))
+		return
+	}
+	if err == nil {
+		if !data.ForceRegenerate.ValueBool() {
+			resp.Diagnostics.AddError("A team token already exists", fmt.Sprintf("a token already exists for team: %s", teamID))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Regenerating existing token for team: %s", teamID))
+	}
+
+	expiry, err := tokenExpiry(data.ExpiredAt)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expired_at"), "Invalid date", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create ephemeral token for team: %s", teamID))
+	token, err := r.config.Client.TeamTokens.CreateWithOptions(ctx, teamID, tfe.TeamTokenCreateOptions{ExpiredAt: expiry})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create team token", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateToken(ctx, resp.Private, privateTeamToken{TeamID: teamID})...)
+
+	data.Token = types.StringValue(token.Token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ephemeralTeamToken) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	var private privateTeamToken
+	found, diags := getPrivateToken(ctx, req.Private, &private)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Revoke ephemeral token of team: %s", private.TeamID))
+	err := r.config.Client.TeamTokens.Delete(ctx, private.TeamID)
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		resp.Diagnostics.AddError("Unable to revoke team token", fmt.Sprintf("error revoking the token of team %s: %s", private.TeamID, err))
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEphemeralTeamToken_openClose(t *testing.T) {
+	api := newTestEphemeralTokenAPI(t, map[string]string{
+		"POST /api/v2/teams/team-123/authentication-token": `{"data": {"id": "at-123", "type": "authentication-tokens", "attributes": {"token": "secret"}}}`,
+	})
+
+	token := api.openClose(t, "tfe_team_token", map[string]tftypes.Value{
+		"team_id": tftypes.NewValue(tftypes.String, "team-123"),
+	})
+	if token != "secret" {
+		t.Fatalf("Expected the token to be %q, got %q", "secret", token)
+	}
+
+	api.assertRequests(t,
+		"GET /api/v2/teams/team-123/authentication-token",
+		"POST /api/v2/teams/team-123/authentication-token",
+		"DELETE /api/v2/teams/team-123/authentication-token",
+	)
+}
+
+func TestEphemeralTeamToken_existing(t *testing.T) {
+	api := newTestEphemeralTokenAPI(t, map[string]string{
+		"GET /api/v2/teams/team-123/authentication-token": `{"data": {"id": "at-123", "type": "authentication-tokens", "attributes": {}}}`,
+	})
+
+	resp := api.open(t, "tfe_team_token", map[string]tftypes.Value{
+		"team_id": tftypes.NewValue(tftypes.String, "team-123"),
+	})
+	if len(resp.Diagnostics) == 0 || resp.Diagnostics[0].Summary != "A team token already exists" {
+		t.Fatalf("Expected an existing token to fail without force_regenerate, got %v", resp.Diagnostics)
+	}
+
+	api.assertRequests(t, "GET /api/v2/teams/team-123/authentication-token")
+}
+
+// testEphemeralTokenAPI is a fake API for the ephemeral token resources,
+// served to a configured framework provider. It responds to the requests in
+// responses, by method and path, and with a 404 to any other request.
+type testEphemeralTokenAPI struct {
+	server tfprotov5.ProviderServer
+
+	mu       sync.Mutex
+	requests []string
+}
+
+func newTestEphemeralTokenAPI(t *testing.T, responses map[string]string) *testEphemeralTokenAPI {
+	t.Helper()
+
+	api := &testEphemeralTokenAPI{
+		server: providerserver.NewProtocol5(NewFrameworkProvider())(),
+	}
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/terraform.json":
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, `{"tfe.v2": "/api/v2/", "tfe.v2.1": "/api/v2/", "tfe.v2.2": "/api/v2/"}`)
+			return
+		case "/api/v2/ping":
+			w.Header().Set("TFP-API-Version", "2.5")
+			return
+		}
+
+		request := r.Method + " " + r.URL.Path
+		api.mu.Lock()
+		api.requests = append(api.requests, request)
+		api.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		body, ok := responses[request]
+		switch {
+		case ok:
+			io.WriteString(w, body)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errors": [{"status": "404", "title": "not found"}]}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	serverURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing the test server URL: %v", err)
+	}
+
+	resp, err := api.server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+		Config: testProviderConfig(t, map[string]tftypes.Value{
+			"hostname":        tftypes.NewValue(tftypes.String, serverURL.Host),
+			"token":           tftypes.NewValue(tftypes.String, "test-token"),
+			"ssl_skip_verify": tftypes.NewValue(tftypes.Bool, true),
+			"organization":    tftypes.NewValue(tftypes.String, "hashicorp"),
+		}),
+	})
+	if err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected error configuring the provider: %v %v", err, resp.Diagnostics)
+	}
+
+	return api
+}
+
+// open opens an ephemeral resource whose attributes not in values are null.
+func (api *testEphemeralTokenAPI) open(t *testing.T, typeName string, values map[string]tftypes.Value) *tfprotov5.OpenEphemeralResourceResponse {
+	t.Helper()
+
+	schema, err := api.server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error reading the provider schema: %v", err)
+	}
+	configType := schema.EphemeralResourceSchemas[typeName].ValueType().(tftypes.Object)
+
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, attrs))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	resp, err := api.server.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: typeName,
+		Config:   &config,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error opening %s: %v", typeName, err)
+	}
+	return resp
+}
+
+// openClose opens and closes an ephemeral resource, and returns its token.
+func (api *testEphemeralTokenAPI) openClose(t *testing.T, typeName string, values map[string]tftypes.Value) string {
+	t.Helper()
+
+	resp := api.open(t, typeName, values)
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics opening %s: %v", typeName, resp.Diagnostics[0])
+	}
+
+	schema, _ := api.server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	result, err := resp.Result.Unmarshal(schema.EphemeralResourceSchemas[typeName].ValueType())
+	if err != nil {
+		t.Fatalf("Unexpected error reading the result of %s: %v", typeName, err)
+	}
+	var attrs map[string]tftypes.Value
+	var token string
+	if err := result.As(&attrs); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := attrs["token"].As(&token); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	closeResp, err := api.server.CloseEphemeralResource(context.Background(), &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: typeName,
+		Private:  resp.Private,
+	})
+	if err != nil || len(closeResp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected error closing %s: %v %v", typeName, err, closeResp.Diagnostics)
+	}
+
+	return token
+}
+
+func (api *testEphemeralTokenAPI) assertRequests(t *testing.T, expected ...string) {
+	t.Helper()
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if len(api.requests) != len(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, api.requests)
+	}
+	for i := range expected {
+		if api.requests[i] != expected[i] {
+			t.Fatalf("Expected requests %v, got %v", expected, api.requests)
+		}
+	}
+}
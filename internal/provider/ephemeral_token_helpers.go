@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// privateTokenKey is the key of the private data identifying the token an
+// ephemeral token resource revokes when it is closed.
+const privateTokenKey = "token"
+
+// privateData is the private data of an ephemeral resource, which Terraform
+// passes from Open to Close.
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setPrivateToken saves what identifies the token opened by an ephemeral
+// resource, so it can be revoked when the resource is closed.
+func setPrivateToken(ctx context.Context, private privateData, token any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := json.Marshal(token)
+	if err != nil {
+		diags.AddError("Unable to save the token for revocation", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, privateTokenKey, raw)
+}
+
+// getPrivateToken reads what was saved by setPrivateToken into target. It
+// returns false when nothing was saved, as the resource failed to open.
+func getPrivateToken(ctx context.Context, private privateData, target any) (bool, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, privateTokenKey)
+	if diags.HasError() || raw == nil {
+		return false, diags
+	}
+
+	if err := json.Unmarshal(raw, target); err != nil {
+		diags.AddError("Unable to read the token to revoke", err.Error())
+		return false, diags
+	}
+	return true, diags
+}
+
+// tokenExpiry returns the expiry of a token from its expired_at attribute, or
+// nil when it doesn't expire.
+func tokenExpiry(expiredAt timetypes.RFC3339) (*time.Time, error) {
+	if expiredAt.IsNull() || expiredAt.IsUnknown() {
+		return nil, nil
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiredAt.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt.ValueString())
+	}
+	return &expiry, nil
+}
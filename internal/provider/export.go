@@ -0,0 +1,815 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ExportImportsFile is the file of the import blocks written by
+// ExportOrganization. It can be removed once the resources are imported.
+const ExportImportsFile = "imports.tf"
+
+// exportedResource is a resource of an organization, as configuration.
+type exportedResource struct {
+	typeName string
+	name     string
+	importID string
+	attrs    []exportedAttribute
+
+	// comment is written above the resource, to explain what must be
+	// completed by hand.
+	comment string
+}
+
+// exportedAttribute is an attribute or, when value is an exportedBlock, a
+// nested block of an exportedResource.
+type exportedAttribute struct {
+	name string
+
+	// value is a string, bool, []string, exportedReference,
+	// []exportedReference or exportedBlock.
+	value any
+}
+
+// exportedBlock is a nested block of an exportedResource.
+type exportedBlock []exportedAttribute
+
+// exportedReference is the id of another exported resource.
+type exportedReference struct {
+	typeName string
+	name     string
+}
+
+func (r exportedReference) traversal() hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: r.typeName},
+		hcl.TraverseAttr{Name: r.name},
+		hcl.TraverseAttr{Name: "id"},
+	}
+}
+
+// organizationExporter collects the resources of an organization, in an
+// order where every resource comes after the ones it references.
+type organizationExporter struct {
+	client       *tfe.Client
+	organization string
+
+	resources []*exportedResource
+	names     map[string]map[string]bool
+	refs      map[string]exportedReference
+}
+
+// ExportOrganization reads the projects, workspaces and their execution
+// settings, variables, variable sets, teams, team access, policy sets, run
+// triggers, notification configurations, agent pools and registry modules of
+// an organization. It returns their configuration as .tf files, keyed by file name, with an
+// import block for each resource in ExportImportsFile.
+//
+// Attributes are written only when the schema of the resource, from server,
+// accepts them in configuration, and references between exported resources
+// are written as references rather than IDs.
+func ExportOrganization(ctx context.Context, server tfprotov5.ProviderServer, tfeClient *tfe.Client, organization string) (map[string][]byte, error) {
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	e := &organizationExporter{
+		client:       tfeClient,
+		organization: organization,
+		names:        map[string]map[string]bool{},
+		refs:         map[string]exportedReference{},
+	}
+
+	for _, export := range []func(context.Context) error{
+		e.exportProjects,
+		e.exportAgentPools,
+		e.exportWorkspaces,
+		e.exportVariableSets,
+		e.exportTeams,
+		e.exportPolicySets,
+		e.exportRegistryModules,
+	} {
+		if err := export(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return renderExport(e.resources, resp.ResourceSchemas), nil
+}
+
+// listAll collects every page of a list endpoint.
+func listAll[T any](list func(options tfe.ListOptions) ([]T, *tfe.Pagination, error)) ([]T, error) {
+	var all []T
+	options := tfe.ListOptions{PageSize: 100}
+	for {
+		items, pagination, err := list(options)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if pagination == nil || pagination.CurrentPage >= pagination.TotalPages {
+			return all, nil
+		}
+		options.PageNumber = pagination.NextPage
+	}
+}
+
+// add adds a resource whose API ID is id, giving it a unique name based on
+// label. Resources that can't be referenced are added with an empty id.
+func (e *organizationExporter) add(r *exportedResource, id, label string) {
+	if e.names[r.typeName] == nil {
+		e.names[r.typeName] = map[string]bool{}
+	}
+	r.name = exportName(label)
+	for i := 2; e.names[r.typeName][r.name]; i++ {
+		r.name = fmt.Sprintf("%s_%d", exportName(label), i)
+	}
+	e.names[r.typeName][r.name] = true
+
+	e.resources = append(e.resources, r)
+	if id != "" {
+		e.refs[id] = exportedReference{typeName: r.typeName, name: r.name}
+	}
+}
+
+// ref returns a reference to the exported resource with the API ID id, or id
+// itself when the resource isn't exported.
+func (e *organizationExporter) ref(id string) any {
+	if ref, ok := e.refs[id]; ok {
+		return ref
+	}
+	return id
+}
+
+func (e *organizationExporter) refList(ids []string) any {
+	refs := make([]exportedReference, 0, len(ids))
+	for _, id := range ids {
+		ref, ok := e.refs[id]
+		if !ok {
+			// References and IDs can't be mixed in a list.
+			return ids
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+var exportNameInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportName turns label into a valid name for a resource.
+func exportName(label string) string {
+	name := strings.Trim(exportNameInvalidChars.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	if !hclsyntax.ValidIdentifier(name) {
+		name = "r_" + name
+	}
+	return name
+}
+
+func (e *organizationExporter) exportProjects(ctx context.Context) error {
+	projects, err := listAll(func(options tfe.ListOptions) ([]*tfe.Project, *tfe.Pagination, error) {
+		l, err := e.client.Projects.List(ctx, e.organization, &tfe.ProjectListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing projects: %w", err)
+	}
+
+	for _, p := range projects {
+		e.add(&exportedResource{
+			typeName: "tfe_project",
+			importID: p.ID,
+			attrs: []exportedAttribute{
+				{"name", p.Name},
+				{"organization", e.organization},
+				{"description", p.Description},
+			},
+		}, p.ID, p.Name)
+	}
+	return nil
+}
+
+func (e *organizationExporter) exportAgentPools(ctx context.Context) error {
+	pools, err := listAll(func(options tfe.ListOptions) ([]*tfe.AgentPool, *tfe.Pagination, error) {
+		l, err := e.client.AgentPools.List(ctx, e.organization, &tfe.AgentPoolListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing agent pools: %w", err)
+	}
+
+	for _, p := range pools {
+		e.add(&exportedResource{
+			typeName: "tfe_agent_pool",
+			importID: p.ID,
+			attrs: []exportedAttribute{
+				{"name", p.Name},
+				{"organization", e.organization},
+				{"organization_scoped", p.OrganizationScoped},
+			},
+		}, p.ID, p.Name)
+	}
+	return nil
+}
+
+func (e *organizationExporter) exportWorkspaces(ctx context.Context) error {
+	workspaces, err := listAll(func(options tfe.ListOptions) ([]*tfe.Workspace, *tfe.Pagination, error) {
+		l, err := e.client.Workspaces.List(ctx, e.organization, &tfe.WorkspaceListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing workspaces: %w", err)
+	}
+
+	for _, w := range workspaces {
+		attrs := []exportedAttribute{
+			{"name", w.Name},
+			{"organization", e.organization},
+			{"description", w.Description},
+			{"allow_destroy_plan", w.AllowDestroyPlan},
+			{"assessments_enabled", w.AssessmentsEnabled},
+			{"auto_apply", w.AutoApply},
+			{"auto_apply_run_trigger", w.AutoApplyRunTrigger},
+			{"file_triggers_enabled", w.FileTriggersEnabled},
+			{"speculative_enabled", w.SpeculativeEnabled},
+			{"structured_run_output_enabled", w.StructuredRunOutputEnabled},
+			{"tag_names", w.TagNames},
+			{"terraform_version", w.TerraformVersion},
+			{"trigger_patterns", w.TriggerPatterns},
+			{"trigger_prefixes", w.TriggerPrefixes},
+			{"working_directory", w.WorkingDirectory},
+		}
+		if w.Project != nil {
+			attrs = append(attrs, exportedAttribute{"project_id", e.ref(w.Project.ID)})
+		}
+		if w.VCSRepo != nil {
+			attrs = append(attrs, exportedAttribute{"vcs_repo", exportVCSRepo(w.VCSRepo)})
+		}
+
+		e.add(&exportedResource{
+			typeName: "tfe_workspace",
+			importID: w.ID,
+			attrs:    attrs,
+		}, w.ID, w.Name)
+		e.exportWorkspaceSettings(w)
+	}
+
+	// Resources of a workspace are exported once every workspace is, as run
+	// triggers reference other workspaces.
+	for _, w := range workspaces {
+		if err := e.exportWorkspaceVariables(ctx, w); err != nil {
+			return err
+		}
+		if err := e.exportTeamAccess(ctx, w); err != nil {
+			return err
+		}
+		if err := e.exportRunTriggers(ctx, w); err != nil {
+			return err
+		}
+		if err := e.exportNotificationConfigurations(ctx, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportWorkspaceSettings exports the execution mode of a workspace that runs
+// on an agent pool. tfe_workspace no longer configures it, so without
+// tfe_workspace_settings the workspace would lose its agent pool.
+func (e *organizationExporter) exportWorkspaceSettings(w *tfe.Workspace) {
+	if w.AgentPool == nil {
+		return
+	}
+
+	e.add(&exportedResource{
+		typeName: "tfe_workspace_settings",
+		importID: w.ID,
+		attrs: []exportedAttribute{
+			{"workspace_id", e.ref(w.ID)},
+			{"execution_mode", w.ExecutionMode},
+			{"agent_pool_id", e.ref(w.AgentPool.ID)},
+		},
+	}, "", w.Name)
+}
+
+func exportVCSRepo(repo *tfe.VCSRepo) exportedBlock {
+	return exportedBlock{
+		{"identifier", repo.Identifier},
+		{"display_identifier", repo.DisplayIdentifier},
+		{"branch", repo.Branch},
+		{"oauth_token_id", repo.OAuthTokenID},
+		{"github_app_installation_id", repo.GHAInstallationID},
+		{"ingress_submodules", repo.IngressSubmodules},
+		{"tags_regex", repo.TagsRegex},
+	}
+}
+
+// exportVariable returns a tfe_variable of a workspace or variable set. The
+// values of sensitive variables can't be read, so they are left out.
+func exportVariable(key, value, description string, category tfe.CategoryType, isHCL, sensitive bool) *exportedResource {
+	r := &exportedResource{
+		typeName: "tfe_variable",
+		attrs: []exportedAttribute{
+			{"key", key},
+			{"category", string(category)},
+			{"description", description},
+			{"hcl", isHCL},
+			{"sensitive", sensitive},
+		},
+	}
+	if sensitive {
+		r.comment = "The value of this sensitive variable can't be read, so it must be set by hand."
+	} else {
+		r.attrs = append(r.attrs, exportedAttribute{"value", value})
+	}
+	return r
+}
+
+func (e *organizationExporter) exportWorkspaceVariables(ctx context.Context, w *tfe.Workspace) error {
+	variables, err := listAll(func(options tfe.ListOptions) ([]*tfe.Variable, *tfe.Pagination, error) {
+		l, err := e.client.Variables.List(ctx, w.ID, &tfe.VariableListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing variables of workspace %s: %w", w.Name, err)
+	}
+
+	for _, v := range variables {
+		r := exportVariable(v.Key, v.Value, v.Description, v.Category, v.HCL, v.Sensitive)
+		r.importID = fmt.Sprintf("%s/%s/%s", e.organization, w.Name, v.ID)
+		r.attrs = append(r.attrs, exportedAttribute{"workspace_id", e.ref(w.ID)})
+		e.add(r, v.ID, w.Name+"_"+v.Key)
+	}
+	return nil
+}
+
+func (e *organizationExporter) exportTeamAccess(ctx context.Context, w *tfe.Workspace) error {
+	accesses, err := listAll(func(options tfe.ListOptions) ([]*tfe.TeamAccess, *tfe.Pagination, error) {
+		l, err := e.client.TeamAccess.List(ctx, &tfe.TeamAccessListOptions{ListOptions: options, WorkspaceID: w.ID})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing team access of workspace %s: %w", w.Name, err)
+	}
+
+	for _, a := range accesses {
+		if a.Team == nil {
+			continue
+		}
+		teamRef := e.teamRef(ctx, a.Team.ID)
+		label := a.Team.ID
+		if ref, ok := teamRef.(exportedReference); ok {
+			label = ref.name
+		}
+
+		attrs := []exportedAttribute{
+			{"team_id", teamRef},
+			{"workspace_id", e.ref(w.ID)},
+		}
+		if a.Access == tfe.AccessCustom {
+			attrs = append(attrs, exportedAttribute{"permissions", exportedBlock{
+				{"runs", string(a.Runs)},
+				{"variables", string(a.Variables)},
+				{"state_versions", string(a.StateVersions)},
+				{"sentinel_mocks", string(a.SentinelMocks)},
+				{"workspace_locking", a.WorkspaceLocking},
+				{"run_tasks", a.RunTasks},
+			}})
+		} else {
+			attrs = append(attrs, exportedAttribute{"access", string(a.Access)})
+		}
+
+		e.add(&exportedResource{
+			typeName: "tfe_team_access",
+			importID: fmt.Sprintf("%s/%s/%s", e.organization, w.Name, a.ID),
+			attrs:    attrs,
+		}, a.ID, label+"_"+w.Name)
+	}
+	return nil
+}
+
+// teamRef returns a reference to a team. Teams are exported after
+// workspaces, so they are exported on their first reference.
+func (e *organizationExporter) teamRef(ctx context.Context, teamID string) any {
+	if _, ok := e.refs[teamID]; !ok {
+		team, err := e.client.Teams.Read(ctx, teamID)
+		if err != nil {
+			log.Printf("[WARN] Failed to read team %s, it is referenced by ID: %v", teamID, err)
+			return teamID
+		}
+		e.exportTeam(team)
+	}
+	return e.ref(teamID)
+}
+
+func (e *organizationExporter) exportRunTriggers(ctx context.Context, w *tfe.Workspace) error {
+	triggers, err := listAll(func(options tfe.ListOptions) ([]*tfe.RunTrigger, *tfe.Pagination, error) {
+		l, err := e.client.RunTriggers.List(ctx, w.ID, &tfe.RunTriggerListOptions{
+			ListOptions:    options,
+			RunTriggerType: tfe.RunTriggerInbound,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing run triggers of workspace %s: %w", w.Name, err)
+	}
+
+	for _, t := range triggers {
+		if t.Sourceable == nil {
+			continue
+		}
+		e.add(&exportedResource{
+			typeName: "tfe_run_trigger",
+			importID: t.ID,
+			attrs: []exportedAttribute{
+				{"workspace_id", e.ref(w.ID)},
+				{"sourceable_id", e.ref(t.Sourceable.ID)},
+			},
+		}, t.ID, t.SourceableName+"_to_"+w.Name)
+	}
+	return nil
+}
+
+func (e *organizationExporter) exportNotificationConfigurations(ctx context.Context, w *tfe.Workspace) error {
+	notifications, err := listAll(func(options tfe.ListOptions) ([]*tfe.NotificationConfiguration, *tfe.Pagination, error) {
+		l, err := e.client.NotificationConfigurations.List(ctx, w.ID, &tfe.NotificationConfigurationListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing notification configurations of workspace %s: %w", w.Name, err)
+	}
+
+	for _, n := range notifications {
+		var userIDs []string
+		for _, u := range n.EmailUsers {
+			userIDs = append(userIDs, u.ID)
+		}
+
+		r := &exportedResource{
+			typeName: "tfe_notification_configuration",
+			importID: n.ID,
+			attrs: []exportedAttribute{
+				{"name", n.Name},
+				{"destination_type", string(n.DestinationType)},
+				{"enabled", n.Enabled},
+				{"triggers", n.Triggers},
+				{"url", n.URL},
+				{"email_addresses", n.EmailAddresses},
+				{"email_user_ids", userIDs},
+				{"workspace_id", e.ref(w.ID)},
+			},
+		}
+		if n.DestinationType == tfe.NotificationDestinationTypeGeneric {
+			r.comment = "The token of this notification configuration can't be read, so it must be set by hand if one is used."
+		}
+		e.add(r, n.ID, w.Name+"_"+n.Name)
+	}
+	return nil
+}
+
+func (e *organizationExporter) exportVariableSets(ctx context.Context) error {
+	sets, err := listAll(func(options tfe.ListOptions) ([]*tfe.VariableSet, *tfe.Pagination, error) {
+		l, err := e.client.VariableSets.List(ctx, e.organization, &tfe.VariableSetListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing variable sets: %w", err)
+	}
+
+	for _, s := range sets {
+		attrs := []exportedAttribute{
+			{"name", s.Name},
+			{"organization", e.organization},
+			{"description", s.Description},
+			{"global", s.Global},
+			{"priority", s.Priority},
+		}
+		if s.Parent != nil && s.Parent.Project != nil {
+			attrs = append(attrs, exportedAttribute{"parent_project_id", e.ref(s.Parent.Project.ID)})
+		}
+		e.add(&exportedResource{
+			typeName: "tfe_variable_set",
+			importID: s.ID,
+			attrs:    attrs,
+		}, s.ID, s.Name)
+
+		variables, err := listAll(func(options tfe.ListOptions) ([]*tfe.VariableSetVariable, *tfe.Pagination, error) {
+			l, err := e.client.VariableSetVariables.List(ctx, s.ID, &tfe.VariableSetVariableListOptions{ListOptions: options})
+			if err != nil {
+				return nil, nil, err
+			}
+			return l.Items, l.Pagination, nil
+		})
+		if err != nil {
+			return fmt.Errorf("error listing variables of variable set %s: %w", s.Name, err)
+		}
+
+		for _, v := range variables {
+			r := exportVariable(v.Key, v.Value, v.Description, v.Category, v.HCL, v.Sensitive)
+			r.importID = fmt.Sprintf("%s/%s/%s", e.organization, s.ID, v.ID)
+			r.attrs = append(r.attrs, exportedAttribute{"variable_set_id", e.ref(s.ID)})
+			e.add(r, v.ID, s.Name+"_"+v.Key)
+		}
+	}
+	return nil
+}
+
+func (e *organizationExporter) exportTeams(ctx context.Context) error {
+	teams, err := listAll(func(options tfe.ListOptions) ([]*tfe.Team, *tfe.Pagination, error) {
+		l, err := e.client.Teams.List(ctx, e.organization, &tfe.TeamListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing teams: %w", err)
+	}
+
+	for _, t := range teams {
+		if _, ok := e.refs[t.ID]; !ok {
+			e.exportTeam(t)
+		}
+	}
+	return nil
+}
+
+func (e *organizationExporter) exportTeam(t *tfe.Team) {
+	attrs := []exportedAttribute{
+		{"name", t.Name},
+		{"organization", e.organization},
+		{"visibility", t.Visibility},
+		{"sso_team_id", t.SSOTeamID},
+		{"allow_member_token_management", t.AllowMemberTokenManagement},
+	}
+	if a := t.OrganizationAccess; a != nil {
+		attrs = append(attrs, exportedAttribute{"organization_access", exportedBlock{
+			{"read_workspaces", a.ReadWorkspaces},
+			{"read_projects", a.ReadProjects},
+			{"manage_workspaces", a.ManageWorkspaces},
+			{"manage_projects", a.ManageProjects},
+			{"manage_policies", a.ManagePolicies},
+			{"manage_policy_overrides", a.ManagePolicyOverrides},
+			{"manage_vcs_settings", a.ManageVCSSettings},
+			{"manage_providers", a.ManageProviders},
+			{"manage_modules", a.ManageModules},
+			{"manage_run_tasks", a.ManageRunTasks},
+			{"manage_membership", a.ManageMembership},
+			{"manage_teams", a.ManageTeams},
+			{"manage_organization_access", a.ManageOrganizationAccess},
+			{"access_secret_teams", a.AccessSecretTeams},
+			{"manage_agent_pools", a.ManageAgentPools},
+		}})
+	}
+
+	r := &exportedResource{
+		typeName: "tfe_team",
+		importID: fmt.Sprintf("%s/%s", e.organization, t.ID),
+		attrs:    attrs,
+	}
+	if t.Name == "owners" {
+		r.comment = "The owners team can't be deleted, and its organization access can't be changed."
+	}
+	e.add(r, t.ID, t.Name)
+}
+
+func (e *organizationExporter) exportPolicySets(ctx context.Context) error {
+	sets, err := listAll(func(options tfe.ListOptions) ([]*tfe.PolicySet, *tfe.Pagination, error) {
+		l, err := e.client.PolicySets.List(ctx, e.organization, &tfe.PolicySetListOptions{
+			ListOptions: options,
+			Include:     []tfe.PolicySetIncludeOpt{tfe.PolicySetWorkspaces},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing policy sets: %w", err)
+	}
+
+	for _, s := range sets {
+		attrs := []exportedAttribute{
+			{"name", s.Name},
+			{"organization", e.organization},
+			{"description", s.Description},
+			{"kind", string(s.Kind)},
+			{"global", s.Global},
+			{"agent_enabled", s.AgentEnabled},
+			{"policy_tool_version", s.PolicyToolVersion},
+			{"policies_path", s.PoliciesPath},
+		}
+		if s.Overridable != nil {
+			attrs = append(attrs, exportedAttribute{"overridable", *s.Overridable})
+		}
+		if !s.Global {
+			var workspaceIDs []string
+			for _, w := range s.Workspaces {
+				workspaceIDs = append(workspaceIDs, w.ID)
+			}
+			attrs = append(attrs, exportedAttribute{"workspace_ids", e.refList(workspaceIDs)})
+		}
+		if s.VCSRepo != nil {
+			attrs = append(attrs, exportedAttribute{"vcs_repo", exportVCSRepo(s.VCSRepo)})
+		}
+
+		r := &exportedResource{
+			typeName: "tfe_policy_set",
+			importID: s.ID,
+			attrs:    attrs,
+		}
+		if s.VCSRepo == nil && s.PolicyCount > 0 {
+			r.comment = "The policies of this policy set aren't exported."
+		}
+		e.add(r, s.ID, s.Name)
+	}
+	return nil
+}
+
+func (e *organizationExporter) exportRegistryModules(ctx context.Context) error {
+	modules, err := listAll(func(options tfe.ListOptions) ([]*tfe.RegistryModule, *tfe.Pagination, error) {
+		l, err := e.client.RegistryModules.List(ctx, e.organization, &tfe.RegistryModuleListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing registry modules: %w", err)
+	}
+
+	for _, m := range modules {
+		attrs := []exportedAttribute{
+			{"organization", e.organization},
+		}
+		if m.VCSRepo != nil {
+			attrs = append(attrs, exportedAttribute{"vcs_repo", exportVCSRepo(m.VCSRepo)})
+		} else {
+			attrs = append(attrs,
+				exportedAttribute{"name", m.Name},
+				exportedAttribute{"module_provider", m.Provider},
+				exportedAttribute{"namespace", m.Namespace},
+				exportedAttribute{"registry_name", string(m.RegistryName)},
+			)
+		}
+
+		e.add(&exportedResource{
+			typeName: "tfe_registry_module",
+			importID: fmt.Sprintf("%s/%s/%s/%s/%s/%s", e.organization, m.RegistryName, m.Namespace, m.Name, m.Provider, m.ID),
+			attrs:    attrs,
+		}, m.ID, m.Namespace+"_"+m.Name+"_"+m.Provider)
+	}
+	return nil
+}
+
+// renderExport writes the resources to a file per resource type, named after
+// the type without its tfe_ prefix, and their import blocks to
+// ExportImportsFile.
+func renderExport(resources []*exportedResource, schemas map[string]*tfprotov5.Schema) map[string][]byte {
+	files := map[string]*hclwrite.File{}
+	imports := hclwrite.NewEmptyFile()
+
+	for _, r := range resources {
+		schema, ok := schemas[r.typeName]
+		if !ok || schema.Block == nil {
+			log.Printf("[WARN] Skipping %s.%s, as the provider has no schema for it", r.typeName, r.name)
+			continue
+		}
+
+		fileName := strings.TrimPrefix(r.typeName, "tfe_") + ".tf"
+		f, ok := files[fileName]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			files[fileName] = f
+		} else {
+			f.Body().AppendNewline()
+		}
+
+		if r.comment != "" {
+			f.Body().AppendUnstructuredTokens(hclwrite.Tokens{
+				{Type: hclsyntax.TokenComment, Bytes: []byte("# " + r.comment + "\n")},
+			})
+		}
+		block := f.Body().AppendNewBlock("resource", []string{r.typeName, r.name})
+		renderAttributes(block.Body(), r.attrs, schema.Block, r.typeName)
+
+		if len(imports.Body().Blocks()) > 0 {
+			imports.Body().AppendNewline()
+		}
+		importBlock := imports.Body().AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.typeName},
+			hcl.TraverseAttr{Name: r.name},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(r.importID))
+	}
+
+	out := map[string][]byte{ExportImportsFile: imports.Bytes()}
+	for name, f := range files {
+		out[name] = f.Bytes()
+	}
+	return out
+}
+
+// renderAttributes writes the attributes that schema accepts in
+// configuration, leaving out empty values.
+func renderAttributes(body *hclwrite.Body, attrs []exportedAttribute, schema *tfprotov5.SchemaBlock, path string) {
+	attributes := map[string]*tfprotov5.SchemaAttribute{}
+	for _, a := range schema.Attributes {
+		attributes[a.Name] = a
+	}
+	blocks := map[string]*tfprotov5.SchemaNestedBlock{}
+	for _, b := range schema.BlockTypes {
+		blocks[b.TypeName] = b
+	}
+
+	for _, attr := range attrs {
+		if block, ok := attr.value.(exportedBlock); ok {
+			nested, ok := blocks[attr.name]
+			if !ok {
+				log.Printf("[DEBUG] Leaving out block %s.%s, which isn't in the schema", path, attr.name)
+				continue
+			}
+			renderAttributes(body.AppendNewBlock(attr.name, nil).Body(), block, nested.Block, path+"."+attr.name)
+			continue
+		}
+
+		a, ok := attributes[attr.name]
+		if !ok || a.Deprecated || (a.Computed && !a.Optional && !a.Required) {
+			log.Printf("[DEBUG] Leaving out attribute %s.%s, which can't be configured", path, attr.name)
+			continue
+		}
+
+		switch v := attr.value.(type) {
+		case string:
+			if v != "" {
+				body.SetAttributeValue(attr.name, cty.StringVal(v))
+			}
+		case bool:
+			body.SetAttributeValue(attr.name, cty.BoolVal(v))
+		case []string:
+			if len(v) > 0 {
+				values := make([]cty.Value, 0, len(v))
+				for _, s := range v {
+					values = append(values, cty.StringVal(s))
+				}
+				body.SetAttributeValue(attr.name, cty.ListVal(values))
+			}
+		case exportedReference:
+			body.SetAttributeTraversal(attr.name, v.traversal())
+		case []exportedReference:
+			if len(v) > 0 {
+				elems := make([]hclwrite.Tokens, 0, len(v))
+				for _, ref := range v {
+					elems = append(elems, hclwrite.TokensForTraversal(ref.traversal()))
+				}
+				body.SetAttributeRaw(attr.name, hclwrite.TokensForTuple(elems))
+			}
+		default:
+			log.Printf("[WARN] Leaving out attribute %s.%s of unsupported type %T", path, attr.name, v)
+		}
+	}
+}
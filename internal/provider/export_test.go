@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+func TestExportName(t *testing.T) {
+	cases := map[string]string{
+		"my-workspace":     "my_workspace",
+		"Team Ops (prod)":  "team_ops_prod",
+		"2024-migration":   "r_2024_migration",
+		"---":              "r_",
+		"already_valid_01": "already_valid_01",
+	}
+
+	for label, expected := range cases {
+		if name := exportName(label); name != expected {
+			t.Errorf("Expected %q to be named %q, got %q", label, expected, name)
+		}
+	}
+}
+
+func testResourceSchemas(t *testing.T) map[string]*tfprotov5.Schema {
+	t.Helper()
+
+	ctx := context.Background()
+	mux, err := tf5muxserver.NewMuxServer(ctx, providerserver.NewProtocol5(NewFrameworkProvider()), PluginProviderServer, Provider().GRPCProvider)
+	if err != nil {
+		t.Fatalf("Unexpected error creating the mux server: %v", err)
+	}
+	schema, err := mux.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error reading the provider schema: %v", err)
+	}
+	return schema.ResourceSchemas
+}
+
+func TestRenderExport(t *testing.T) {
+	e := &organizationExporter{
+		organization: "hashicorp",
+		names:        map[string]map[string]bool{},
+		refs:         map[string]exportedReference{},
+	}
+	e.add(&exportedResource{
+		typeName: "tfe_project",
+		importID: "prj-123",
+		attrs: []exportedAttribute{
+			{"name", "Platform"},
+			{"organization", "hashicorp"},
+			{"description", ""},
+		},
+	}, "prj-123", "Platform")
+	e.add(&exportedResource{
+		typeName: "tfe_workspace",
+		importID: "ws-123",
+		attrs: []exportedAttribute{
+			{"name", "network"},
+			{"organization", "hashicorp"},
+			{"auto_apply", true},
+			{"tag_names", []string{"prod", "network"}},
+			{"execution_mode", "remote"},
+			{"resource_count", "3"},
+			{"project_id", e.ref("prj-123")},
+			{"vcs_repo", exportedBlock{
+				{"identifier", "hashicorp/network"},
+				{"display_identifier", "hashicorp/network"},
+				{"oauth_token_id", "ot-123"},
+			}},
+		},
+	}, "ws-123", "network")
+	e.add(&exportedResource{
+		typeName: "tfe_workspace",
+		importID: "ws-456",
+		attrs: []exportedAttribute{
+			{"name", "Network"},
+			{"project_id", e.ref("prj-unknown")},
+		},
+	}, "ws-456", "Network")
+	secret := exportVariable("password", "hunter2", "", "env", false, true)
+	secret.importID = "hashicorp/network/var-123"
+	secret.attrs = append(secret.attrs, exportedAttribute{"workspace_id", e.ref("ws-123")})
+	e.add(secret, "var-123", "network_password")
+
+	files := renderExport(e.resources, testResourceSchemas(t))
+
+	expected := map[string]string{
+		"project.tf": `resource "tfe_project" "platform" {
+  name         = "Platform"
+  organization = "hashicorp"
+}
+`,
+		"workspace.tf": `resource "tfe_workspace" "network" {
+  name         = "network"
+  organization = "hashicorp"
+  auto_apply   = true
+  tag_names    = ["prod", "network"]
+  project_id   = tfe_project.platform.id
+  vcs_repo {
+    identifier     = "hashicorp/network"
+    oauth_token_id = "ot-123"
+  }
+}
+
+resource "tfe_workspace" "network_2" {
+  name       = "Network"
+  project_id = "prj-unknown"
+}
+`,
+		"variable.tf": `# The value of this sensitive variable can't be read, so it must be set by hand.
+resource "tfe_variable" "network_password" {
+  key          = "password"
+  category     = "env"
+  hcl          = false
+  sensitive    = true
+  workspace_id = tfe_workspace.network.id
+}
+`,
+		ExportImportsFile: `import {
+  to = tfe_project.platform
+  id = "prj-123"
+}
+
+import {
+  to = tfe_workspace.network
+  id = "ws-123"
+}
+
+import {
+  to = tfe_workspace.network_2
+  id = "ws-456"
+}
+
+import {
+  to = tfe_variable.network_password
+  id = "hashicorp/network/var-123"
+}
+`,
+	}
+
+	if len(files) != len(expected) {
+		t.Errorf("Expected %d files, got %d", len(expected), len(files))
+	}
+	for name, content := range expected {
+		if string(files[name]) != content {
+			t.Errorf("Unexpected content of %s:\n%s\nexpected:\n%s", name, files[name], content)
+		}
+	}
+}
+
+func TestExportWorkspaceSettings(t *testing.T) {
+	e := &organizationExporter{
+		organization: "hashicorp",
+		names:        map[string]map[string]bool{},
+		refs:         map[string]exportedReference{},
+	}
+	e.add(&exportedResource{
+		typeName: "tfe_agent_pool",
+		importID: "apool-123",
+		attrs:    []exportedAttribute{{"name", "on-prem"}},
+	}, "apool-123", "on-prem")
+
+	for _, w := range []*tfe.Workspace{
+		{ID: "ws-123", Name: "network", ExecutionMode: "agent", AgentPool: &tfe.AgentPool{ID: "apool-123"}},
+		{ID: "ws-456", Name: "compute", ExecutionMode: "remote"},
+	} {
+		e.add(&exportedResource{
+			typeName: "tfe_workspace",
+			importID: w.ID,
+			attrs:    []exportedAttribute{{"name", w.Name}},
+		}, w.ID, w.Name)
+		e.exportWorkspaceSettings(w)
+	}
+
+	files := renderExport(e.resources, testResourceSchemas(t))
+
+	expected := `resource "tfe_workspace_settings" "network" {
+  workspace_id   = tfe_workspace.network.id
+  execution_mode = "agent"
+  agent_pool_id  = tfe_agent_pool.on_prem.id
+}
+`
+	if string(files["workspace_settings.tf"]) != expected {
+		t.Errorf("Unexpected content of workspace_settings.tf:\n%s\nexpected:\n%s", files["workspace_settings.tf"], expected)
+	}
+	if !strings.Contains(string(files[ExportImportsFile]), `to = tfe_workspace_settings.network
+  id = "ws-123"`) {
+		t.Errorf("Expected an import block for the workspace settings, got:\n%s", files[ExportImportsFile])
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type functionIsID struct{}
+
+var _ function.Function = &functionIsID{}
+
+func NewIsIDFunction() function.Function {
+	return &functionIsID{}
+}
+
+func (f *functionIsID) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_id"
+}
+
+func (f *functionIsID) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks whether a string is an ID of the given type",
+		Description: "Returns true when the string is an ID of the form <PREFIX>-<16 base58 characters>, such as ws-3fNtZ9YKsbMR2yDA for the prefix ws.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "The prefix of the type of ID, such as ws, prj or varset.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "The string to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *functionIsID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, id string
+	resp.Error = req.Arguments.Get(ctx, &prefix, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, isResourceIDFormat(regexp.QuoteMeta(prefix), id))
+}
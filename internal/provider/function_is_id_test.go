@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFunctionIsID(t *testing.T) {
+	cases := map[string]struct {
+		prefix, id string
+		expected   bool
+	}{
+		"workspace ID":      {"ws", "ws-3fNtZ9YKsbMR2yDA", true},
+		"other prefix":      {"prj", "ws-3fNtZ9YKsbMR2yDA", false},
+		"too short":         {"ws", "ws-3fNtZ9YKsbMR2yD", false},
+		"not base58":        {"ws", "ws-3fNtZ9YKsbMR2y0A", false},
+		"workspace name":    {"ws", "my-workspace", false},
+		"regexp in prefix":  {"w.", "ws-3fNtZ9YKsbMR2yDA", false},
+		"variable set ID":   {"varset", "varset-3fNtZ9YKsbMR2yDA", true},
+		"empty ID":          {"ws", "", false},
+		"prefix without ID": {"ws", "ws-", false},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := testCallFunction(t, "is_id",
+				tftypes.NewValue(tftypes.String, c.prefix),
+				tftypes.NewValue(tftypes.String, c.id),
+			)
+			if funcErr != nil {
+				t.Fatalf("Unexpected error: %s", funcErr.Text)
+			}
+
+			var actual bool
+			if err := result.As(&actual); err != nil {
+				t.Fatal(err.Error())
+			}
+			if actual != c.expected {
+				t.Fatalf("Expected is_id(%q, %q) to be %t", c.prefix, c.id, c.expected)
+			}
+		})
+	}
+}
+
+// testCallFunction calls a provider function through the muxed provider
+// server, as Terraform would, and returns its result.
+func testCallFunction(t *testing.T, name string, args ...tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := testAccMuxedProviders["tfe"]()
+	if err != nil {
+		t.Fatalf("Unexpected error creating the provider server: %v", err)
+	}
+
+	schema, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil || len(schema.Diagnostics) > 0 {
+		t.Fatalf("Unexpected error reading the provider schema: %v %v", err, schema.Diagnostics)
+	}
+	definition, ok := schema.Functions[name]
+	if !ok {
+		t.Fatalf("Expected a function named %s", name)
+	}
+
+	arguments := make([]*tfprotov5.DynamicValue, len(args))
+	for i, arg := range args {
+		v, err := tfprotov5.NewDynamicValue(arg.Type(), arg)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		arguments[i] = &v
+	}
+
+	resp, err := server.CallFunction(ctx, &tfprotov5.CallFunctionRequest{
+		Name:      name,
+		Arguments: arguments,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error calling %s: %v", name, err)
+	}
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error
+	}
+
+	result, err := resp.Result.Unmarshal(definition.Return.Type)
+	if err != nil {
+		t.Fatalf("Unexpected error reading the result of %s: %v", name, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type functionParseWorkspaceID struct{}
+
+var _ function.Function = &functionParseWorkspaceID{}
+
+func NewParseWorkspaceIDFunction() function.Function {
+	return &functionParseWorkspaceID{}
+}
+
+// parsedWorkspaceIDAttrTypes are the attributes of the object returned by
+// parse_workspace_id.
+var parsedWorkspaceIDAttrTypes = map[string]attr.Type{
+	"organization": types.StringType,
+	"name":         types.StringType,
+}
+
+func (f *functionParseWorkspaceID) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_workspace_id"
+}
+
+func (f *functionParseWorkspaceID) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parses a workspace ID of the form organization/name",
+		Description: "Returns an object with the organization and name of a workspace ID of the form <ORGANIZATION>/<WORKSPACE>, as used by import IDs and the workspace_id of older resources.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The workspace ID to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedWorkspaceIDAttrTypes,
+		},
+	}
+}
+
+func (f *functionParseWorkspaceID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	organization, name, err := unpackWorkspaceID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(parsedWorkspaceIDAttrTypes, map[string]attr.Value{
+		"organization": types.StringValue(organization),
+		"name":         types.StringValue(name),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
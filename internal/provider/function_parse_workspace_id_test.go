@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFunctionParseWorkspaceID(t *testing.T) {
+	cases := map[string]struct {
+		id                 string
+		organization, name string
+	}{
+		"organization and name": {"hashicorp/my-workspace", "hashicorp", "my-workspace"},
+		"legacy format":         {"my-workspace|hashicorp", "hashicorp", "my-workspace"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := testCallFunction(t, "parse_workspace_id", tftypes.NewValue(tftypes.String, c.id))
+			if funcErr != nil {
+				t.Fatalf("Unexpected error: %s", funcErr.Text)
+			}
+
+			var attrs map[string]tftypes.Value
+			var organization, workspaceName string
+			if err := result.As(&attrs); err != nil {
+				t.Fatal(err.Error())
+			}
+			if err := attrs["organization"].As(&organization); err != nil {
+				t.Fatal(err.Error())
+			}
+			if err := attrs["name"].As(&workspaceName); err != nil {
+				t.Fatal(err.Error())
+			}
+			if organization != c.organization || workspaceName != c.name {
+				t.Fatalf("Expected %q/%q, got %q/%q", c.organization, c.name, organization, workspaceName)
+			}
+		})
+	}
+}
+
+func TestFunctionParseWorkspaceID_invalid(t *testing.T) {
+	_, funcErr := testCallFunction(t, "parse_workspace_id", tftypes.NewValue(tftypes.String, "my-workspace"))
+	if funcErr == nil {
+		t.Fatal("Expected an error parsing a workspace ID without an organization")
+	}
+	if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+		t.Fatalf("Expected the error to be about the id argument, got %v", funcErr.FunctionArgument)
+	}
+}
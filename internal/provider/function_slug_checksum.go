@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type functionSlugChecksum struct{}
+
+var _ function.Function = &functionSlugChecksum{}
+
+func NewSlugChecksumFunction() function.Function {
+	return &functionSlugChecksum{}
+}
+
+func (f *functionSlugChecksum) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slug_checksum"
+}
+
+func (f *functionSlugChecksum) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Computes the checksum of a slug of a directory",
+		Description: "Returns the SHA-256 checksum of the slug of a directory, as computed by the tfe_slug data source, which changes whenever a file uploaded from the directory changes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "path",
+				Description: "The path of the directory.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *functionSlugChecksum) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+	resp.Error = req.Arguments.Get(ctx, &path)
+	if resp.Error != nil {
+		return
+	}
+
+	chksum, err := hashPolicies(path)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Error generating the checksum for the source path files: %v", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, chksum)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFunctionSlugChecksum(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.sentinel"), []byte("main = rule { true }\n"), 0o644); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected, err := hashPolicies(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error hashing %s: %v", dir, err)
+	}
+
+	result, funcErr := testCallFunction(t, "slug_checksum", tftypes.NewValue(tftypes.String, dir))
+	if funcErr != nil {
+		t.Fatalf("Unexpected error: %s", funcErr.Text)
+	}
+	var actual string
+	if err := result.As(&actual); err != nil {
+		t.Fatal(err.Error())
+	}
+	if actual != expected {
+		t.Fatalf("Expected the checksum %s, got %s", expected, actual)
+	}
+}
+
+func TestFunctionSlugChecksum_notDirectory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "main.sentinel")
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, funcErr := testCallFunction(t, "slug_checksum", tftypes.NewValue(tftypes.String, path))
+	if funcErr == nil {
+		t.Fatal("Expected an error computing the checksum of a file")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type functionVerifyRunTaskSignature struct{}
+
+var _ function.Function = &functionVerifyRunTaskSignature{}
+
+func NewVerifyRunTaskSignatureFunction() function.Function {
+	return &functionVerifyRunTaskSignature{}
+}
+
+func (f *functionVerifyRunTaskSignature) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verify_run_task_signature"
+}
+
+func (f *functionVerifyRunTaskSignature) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Verifies the signature of a run task request",
+		Description: "Returns true when the signature, as sent in the X-TFC-Task-Signature header of a run task request, is the HMAC-SHA512 of the request body with the HMAC key of the run task.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "body",
+				Description: "The body of the run task request.",
+			},
+			function.StringParameter{
+				Name:        "hmac_key",
+				Description: "The HMAC key of the run task.",
+			},
+			function.StringParameter{
+				Name:        "signature",
+				Description: "The hex encoded signature of the request.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *functionVerifyRunTaskSignature) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var body, hmacKey, signature string
+	resp.Error = req.Arguments.Get(ctx, &body, &hmacKey, &signature)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, verifyRunTaskSignature([]byte(body), []byte(hmacKey), signature))
+}
+
+// verifyRunTaskSignature checks a run task request signature in constant time.
+func verifyRunTaskSignature(body, hmacKey []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha512.New, hmacKey)
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
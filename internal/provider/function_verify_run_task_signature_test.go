@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFunctionVerifyRunTaskSignature(t *testing.T) {
+	body := `{"payload_version":1,"stage":"post_plan"}`
+	// HMAC-SHA512 of body with the key "secret"
+	signature := "53a487e08de2afb5c35ef1288f3ac3e907b83e787ab495334e2ae2b81c8e99e334b6a5c1f5535ba9b3fb460c14b834e007a68e3e6bb8568c283c13fafd6c9a5b"
+
+	cases := map[string]struct {
+		body, key, signature string
+		expected             bool
+	}{
+		"valid":           {body, "secret", signature, true},
+		"wrong key":       {body, "other", signature, false},
+		"changed body":    {body + " ", "secret", signature, false},
+		"not hex encoded": {body, "secret", "not-a-signature", false},
+		"empty signature": {body, "secret", "", false},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, funcErr := testCallFunction(t, "verify_run_task_signature",
+				tftypes.NewValue(tftypes.String, c.body),
+				tftypes.NewValue(tftypes.String, c.key),
+				tftypes.NewValue(tftypes.String, c.signature),
+			)
+			if funcErr != nil {
+				t.Fatalf("Unexpected error: %s", funcErr.Text)
+			}
+
+			var actual bool
+			if err := result.As(&actual); err != nil {
+				t.Fatal(err.Error())
+			}
+			if actual != c.expected {
+				t.Fatalf("Expected the signature to be verified: %t", c.expected)
+			}
+		})
+	}
+}
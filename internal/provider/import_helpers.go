@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importByIDOrName returns an importer accepting either the ID of a resource
+// or <ORGANIZATION>/<NAME>, whose name is resolved to the ID by fetchID with
+// the client for that organization. Organization names can't contain a slash,
+// so the name may.
+func importByIDOrName(kind string, fetchID func(ctx context.Context, client *tfe.Client, organization, name string) (string, error)) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		s := strings.SplitN(d.Id(), "/", 2)
+		if len(s) == 1 {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		config := meta.(ConfiguredClient)
+		if err := config.useOrganization(s[0]); err != nil {
+			return nil, err
+		}
+		id, err := fetchID(ctx, config.Client, s[0], s[1])
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving %s %s from organization %s: %w", kind, s[1], s[0], err)
+		}
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// findImportMatch returns the one item of items named name, so a resource can
+// be imported by name instead of by ID. It returns an error wrapping
+// tfe.ErrResourceNotFound when no item matches, and an error listing the IDs
+// of the matches when the name is ambiguous.
+func findImportMatch[T any](kind, name string, items []T, nameOf, idOf func(T) string) (T, error) {
+	var matches []T
+	for _, item := range items {
+		if nameOf(item) == name {
+			matches = append(matches, item)
+		}
+	}
+
+	var zero T
+	switch len(matches) {
+	case 0:
+		return zero, fmt.Errorf("%s %q not found: %w", kind, name, tfe.ErrResourceNotFound)
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, len(matches))
+	for i, match := range matches {
+		ids[i] = idOf(match)
+	}
+	return zero, fmt.Errorf("%s %q is ambiguous, as it matches %d resources (%s): import it by ID instead",
+		kind, name, len(matches), strings.Join(ids, ", "))
+}
+
+// fetchProjectByName returns the project in an organization by name.
+func fetchProjectByName(ctx context.Context, client *tfe.Client, organization, name string) (*tfe.Project, error) {
+	projects, err := listAll(func(options tfe.ListOptions) ([]*tfe.Project, *tfe.Pagination, error) {
+		l, err := client.Projects.List(ctx, organization, &tfe.ProjectListOptions{ListOptions: options, Name: name})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing projects of organization %s: %w", organization, err)
+	}
+
+	return findImportMatch("project", name, projects,
+		func(p *tfe.Project) string { return p.Name },
+		func(p *tfe.Project) string { return p.ID })
+}
+
+// fetchProjectExternalID returns the ID of a project given either its ID or
+// its name in an organization.
+func fetchProjectExternalID(ctx context.Context, organization, nameOrID string, client *tfe.Client) (string, error) {
+	if isResourceIDFormat("prj", nameOrID) {
+		return nameOrID, nil
+	}
+
+	return fetchProjectID(ctx, client, organization, nameOrID)
+}
+
+// fetchPolicySetByName returns the policy set in an organization by name.
+func fetchPolicySetByName(ctx context.Context, client *tfe.Client, organization, name string) (*tfe.PolicySet, error) {
+	policySets, err := listAll(func(options tfe.ListOptions) ([]*tfe.PolicySet, *tfe.Pagination, error) {
+		l, err := client.PolicySets.List(ctx, organization, &tfe.PolicySetListOptions{ListOptions: options, Search: name})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing policy sets of organization %s: %w", organization, err)
+	}
+
+	return findImportMatch("policy set", name, policySets,
+		func(ps *tfe.PolicySet) string { return ps.Name },
+		func(ps *tfe.PolicySet) string { return ps.ID })
+}
+
+// fetchPolicyByName returns the policy in an organization by name.
+func fetchPolicyByName(ctx context.Context, client *tfe.Client, organization, name string) (*tfe.Policy, error) {
+	policies, err := listAll(func(options tfe.ListOptions) ([]*tfe.Policy, *tfe.Pagination, error) {
+		l, err := client.Policies.List(ctx, organization, &tfe.PolicyListOptions{ListOptions: options, Search: name})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing policies of organization %s: %w", organization, err)
+	}
+
+	return findImportMatch("policy", name, policies,
+		func(p *tfe.Policy) string { return p.Name },
+		func(p *tfe.Policy) string { return p.ID })
+}
+
+// fetchVariableSetByName returns the variable set in an organization by name.
+func fetchVariableSetByName(ctx context.Context, client *tfe.Client, organization, name string) (*tfe.VariableSet, error) {
+	variableSets, err := listAll(func(options tfe.ListOptions) ([]*tfe.VariableSet, *tfe.Pagination, error) {
+		l, err := client.VariableSets.List(ctx, organization, &tfe.VariableSetListOptions{ListOptions: options, Query: name})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing variable sets of organization %s: %w", organization, err)
+	}
+
+	return findImportMatch("variable set", name, variableSets,
+		func(vs *tfe.VariableSet) string { return vs.Name },
+		func(vs *tfe.VariableSet) string { return vs.ID })
+}
+
+// fetchNotificationConfigurationByName returns the notification
+// configuration of a workspace by name. Names aren't unique in a workspace,
+// so the name may be ambiguous.
+func fetchNotificationConfigurationByName(ctx context.Context, client *tfe.Client, workspaceID, name string) (*tfe.NotificationConfiguration, error) {
+	notifications, err := listAll(func(options tfe.ListOptions) ([]*tfe.NotificationConfiguration, *tfe.Pagination, error) {
+		l, err := client.NotificationConfigurations.List(ctx, workspaceID, &tfe.NotificationConfigurationListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing notification configurations of workspace %s: %w", workspaceID, err)
+	}
+
+	return findImportMatch("notification configuration", name, notifications,
+		func(nc *tfe.NotificationConfiguration) string { return nc.Name },
+		func(nc *tfe.NotificationConfiguration) string { return nc.ID })
+}
+
+// fetchRunTriggerBySource returns the run trigger of a workspace that is
+// triggered by the source workspace.
+func fetchRunTriggerBySource(ctx context.Context, client *tfe.Client, workspaceID, sourceableID string) (*tfe.RunTrigger, error) {
+	runTriggers, err := listAll(func(options tfe.ListOptions) ([]*tfe.RunTrigger, *tfe.Pagination, error) {
+		l, err := client.RunTriggers.List(ctx, workspaceID, &tfe.RunTriggerListOptions{ListOptions: options, RunTriggerType: tfe.RunTriggerInbound})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing run triggers of workspace %s: %w", workspaceID, err)
+	}
+
+	return findImportMatch("run trigger from workspace", sourceableID, runTriggers,
+		func(rt *tfe.RunTrigger) string {
+			if rt.Sourceable == nil {
+				return ""
+			}
+			return rt.Sourceable.ID
+		},
+		func(rt *tfe.RunTrigger) string { return rt.ID })
+}
+
+// fetchTeamAccessByTeam returns the access of a team to a workspace.
+func fetchTeamAccessByTeam(ctx context.Context, client *tfe.Client, workspaceID string, team *tfe.Team) (*tfe.TeamAccess, error) {
+	accesses, err := listAll(func(options tfe.ListOptions) ([]*tfe.TeamAccess, *tfe.Pagination, error) {
+		l, err := client.TeamAccess.List(ctx, &tfe.TeamAccessListOptions{ListOptions: options, WorkspaceID: workspaceID})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing team access of workspace %s: %w", workspaceID, err)
+	}
+
+	return findImportMatch("access of team", team.Name, accesses,
+		func(ta *tfe.TeamAccess) string {
+			if ta.Team == nil || ta.Team.ID != team.ID {
+				return ""
+			}
+			return team.Name
+		},
+		func(ta *tfe.TeamAccess) string { return ta.ID })
+}
+
+// fetchTeamProjectAccessByTeam returns the access of a team to a project.
+func fetchTeamProjectAccessByTeam(ctx context.Context, client *tfe.Client, projectID string, team *tfe.Team) (*tfe.TeamProjectAccess, error) {
+	accesses, err := listAll(func(options tfe.ListOptions) ([]*tfe.TeamProjectAccess, *tfe.Pagination, error) {
+		l, err := client.TeamProjectAccess.List(ctx, tfe.TeamProjectAccessListOptions{ListOptions: options, ProjectID: projectID})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing team access of project %s: %w", projectID, err)
+	}
+
+	return findImportMatch("access of team", team.Name, accesses,
+		func(tpa *tfe.TeamProjectAccess) string {
+			if tpa.Team == nil || tpa.Team.ID != team.ID {
+				return ""
+			}
+			return team.Name
+		},
+		func(tpa *tfe.TeamProjectAccess) string { return tpa.ID })
+}
+
+// fetchPolicySetParameterByKey returns the parameter of a policy set by key.
+func fetchPolicySetParameterByKey(ctx context.Context, client *tfe.Client, policySetID, key string) (*tfe.PolicySetParameter, error) {
+	parameters, err := listAll(func(options tfe.ListOptions) ([]*tfe.PolicySetParameter, *tfe.Pagination, error) {
+		l, err := client.PolicySetParameters.List(ctx, policySetID, &tfe.PolicySetParameterListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing parameters of policy set %s: %w", policySetID, err)
+	}
+
+	return findImportMatch("parameter", key, parameters,
+		func(p *tfe.PolicySetParameter) string { return p.Key },
+		func(p *tfe.PolicySetParameter) string { return p.ID })
+}
+
+// fetchVariableByKey returns the variable of a workspace by key. A workspace
+// can have a Terraform and an environment variable with the same key, so the
+// key may be ambiguous.
+func fetchVariableByKey(ctx context.Context, client *tfe.Client, workspaceID, key string) (*tfe.Variable, error) {
+	variables, err := listAll(func(options tfe.ListOptions) ([]*tfe.Variable, *tfe.Pagination, error) {
+		l, err := client.Variables.List(ctx, workspaceID, &tfe.VariableListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing variables of workspace %s: %w", workspaceID, err)
+	}
+
+	return findImportMatch("variable", key, variables,
+		func(v *tfe.Variable) string { return v.Key },
+		func(v *tfe.Variable) string { return v.ID })
+}
+
+// fetchVariableSetVariableByKey returns the variable of a variable set by
+// key, which may be ambiguous like that of fetchVariableByKey.
+func fetchVariableSetVariableByKey(ctx context.Context, client *tfe.Client, variableSetID, key string) (*tfe.VariableSetVariable, error) {
+	variables, err := listAll(func(options tfe.ListOptions) ([]*tfe.VariableSetVariable, *tfe.Pagination, error) {
+		l, err := client.VariableSetVariables.List(ctx, variableSetID, &tfe.VariableSetVariableListOptions{ListOptions: options})
+		if err != nil {
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing variables of variable set %s: %w", variableSetID, err)
+	}
+
+	return findImportMatch("variable", key, variables,
+		func(v *tfe.VariableSetVariable) string { return v.Key },
+		func(v *tfe.VariableSetVariable) string { return v.ID })
+}
+
+// fetchTeamID returns the ID of a team in an organization by name.
+func fetchTeamID(ctx context.Context, client *tfe.Client, organization, name string) (string, error) {
+	team, err := fetchTeamByName(ctx, client, organization, name)
+	if err != nil {
+		return "", err
+	}
+	return team.ID, nil
+}
+
+// fetchAgentPoolID returns the ID of an agent pool in an organization by name.
+func fetchAgentPoolID(ctx context.Context, client *tfe.Client, organization, name string) (string, error) {
+	pool, err := fetchAgentPool(ctx, organization, name, client)
+	if err != nil {
+		return "", err
+	}
+	return pool.ID, nil
+}
+
+// fetchProjectID returns the ID of a project in an organization by name.
+func fetchProjectID(ctx context.Context, client *tfe.Client, organization, name string) (string, error) {
+	project, err := fetchProjectByName(ctx, client, organization, name)
+	if err != nil {
+		return "", err
+	}
+	return project.ID, nil
+}
+
+// fetchPolicySetID returns the ID of a policy set in an organization by name.
+func fetchPolicySetID(ctx context.Context, client *tfe.Client, organization, name string) (string, error) {
+	policySet, err := fetchPolicySetByName(ctx, client, organization, name)
+	if err != nil {
+		return "", err
+	}
+	return policySet.ID, nil
+}
+
+// fetchVariableSetID returns the ID of a variable set in an organization by
+// name.
+func fetchVariableSetID(ctx context.Context, client *tfe.Client, organization, name string) (string, error) {
+	variableSet, err := fetchVariableSetByName(ctx, client, organization, name)
+	if err != nil {
+		return "", err
+	}
+	return variableSet.ID, nil
+}
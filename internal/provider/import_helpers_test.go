@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFindImportMatch(t *testing.T) {
+	type item struct{ id, name string }
+	items := []item{{"nc-1", "slack"}, {"nc-2", "email"}, {"nc-3", "slack"}}
+	nameOf := func(i item) string { return i.name }
+	idOf := func(i item) string { return i.id }
+
+	match, err := findImportMatch("notification configuration", "email", items, nameOf, idOf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if match.id != "nc-2" {
+		t.Fatalf("Expected nc-2, got %s", match.id)
+	}
+
+	_, err = findImportMatch("notification configuration", "webhook", items, nameOf, idOf)
+	if !errors.Is(err, tfe.ErrResourceNotFound) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+
+	_, err = findImportMatch("notification configuration", "slack", items, nameOf, idOf)
+	if err == nil {
+		t.Fatal("Expected an error for an ambiguous name")
+	}
+	expected := `notification configuration "slack" is ambiguous, as it matches 2 resources (nc-1, nc-3): import it by ID instead`
+	if err.Error() != expected {
+		t.Fatalf("Expected the error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestFetchVariableByKey(t *testing.T) {
+	client := testImportClient(t, map[string]string{
+		"/api/v2/workspaces/ws-123/vars": `{"data": [
+			{"id": "var-1", "type": "vars", "attributes": {"key": "region", "category": "terraform"}},
+			{"id": "var-2", "type": "vars", "attributes": {"key": "token", "category": "env"}},
+			{"id": "var-3", "type": "vars", "attributes": {"key": "token", "category": "terraform"}}
+		]}`,
+	})
+
+	v, err := fetchVariableByKey(context.Background(), client, "ws-123", "region")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v.ID != "var-1" {
+		t.Fatalf("Expected var-1, got %s", v.ID)
+	}
+
+	// A Terraform and an environment variable may share a key.
+	_, err = fetchVariableByKey(context.Background(), client, "ws-123", "token")
+	if err == nil || !strings.Contains(err.Error(), "var-2, var-3") {
+		t.Fatalf("Expected an error listing the ambiguous variables, got %v", err)
+	}
+}
+
+func TestImportByIDOrName_organizationClient(t *testing.T) {
+	defaultClient, orgClient := &tfe.Client{}, &tfe.Client{}
+	meta := ConfiguredClient{
+		Client:              defaultClient,
+		organizationClients: map[string]*tfe.Client{"acme": orgClient},
+		defaultTokenMissing: true,
+	}
+
+	var got *tfe.Client
+	importer := importByIDOrName("project", func(_ context.Context, client *tfe.Client, _, _ string) (string, error) {
+		got = client
+		return "prj-123", nil
+	})
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, nil)
+	d.SetId("acme/platform")
+	if _, err := importer(context.Background(), d, meta); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != orgClient {
+		t.Fatal("Expected the name to be resolved with the client for acme")
+	}
+	if d.Id() != "prj-123" {
+		t.Fatalf("Expected prj-123, got %s", d.Id())
+	}
+
+	// Without a default token, organizations need a token of their own.
+	d.SetId("hashicorp/platform")
+	if _, err := importer(context.Background(), d, meta); err == nil {
+		t.Fatal("Expected an error for an organization without a token")
+	}
+}
+
+// testImportClient returns a client of a fake API responding to GET requests
+// of the paths in responses, and with a 404 to any other request.
+func testImportClient(t *testing.T, responses map[string]string) *tfe.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		body, ok := responses[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errors": [{"status": "404", "title": "not found"}]}`)
+			return
+		}
+		io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := tfe.NewClient(&tfe.Config{Address: srv.URL, Token: "test-token"})
+	if err != nil {
+		t.Fatalf("Unexpected error creating the client: %v", err)
+	}
+	return client
+}
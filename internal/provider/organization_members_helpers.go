@@ -11,7 +11,7 @@ import (
 	tfe "github.com/hashicorp/go-tfe"
 )
 
-func fetchOrganizationMembers(client *tfe.Client, orgName string) ([]map[string]string, []map[string]string, error) {
+func fetchOrganizationMembers(ctx context.Context, client *tfe.Client, orgName string) ([]map[string]string, []map[string]string, error) {
 	var members []map[string]string
 	var membersWaiting []map[string]string
 
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	tfe "github.com/hashicorp/go-tfe"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// parentReader reads the parent identified by id, and returns the name of its
+// organization, or an empty string when the API doesn't include it.
+type parentReader func(ctx context.Context, client *tfe.Client, id string) (string, error)
+
+// parentKeys are the arguments identifying the parent of the resources without
+// an organization argument, in the order they're looked up.
+var parentKeys = []string{
+	"workspace_id",
+	"project_id",
+	"team_id",
+	"variable_set_id",
+	"policy_set_id",
+	"agent_pool_id",
+	"task_id",
+	"organization_membership_id",
+}
+
+var parentReaders = map[string]parentReader{
+	"workspace_id": func(ctx context.Context, client *tfe.Client, id string) (string, error) {
+		w, err := client.Workspaces.ReadByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return organizationName(w.Organization), nil
+	},
+	"project_id": func(ctx context.Context, client *tfe.Client, id string) (string, error) {
+		p, err := client.Projects.Read(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return organizationName(p.Organization), nil
+	},
+	"team_id": func(ctx context.Context, client *tfe.Client, id string) (string, error) {
+		_, err := client.Teams.Read(ctx, id)
+		return "", err
+	},
+	"variable_set_id": func(ctx context.Context, client *tfe.Client, id string) (string, error) {
+		v, err := client.VariableSets.Read(ctx, id, nil)
+		if err != nil {
+			return "", err
+		}
+		return organizationName(v.Organization), nil
+	},
+	"policy_set_id": func(ctx context.Context, client *tfe.Client, id string) (string, error) {
+		p, err := client.PolicySets.Read(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return organizationName(p.Organization), nil
+	},
+	"agent_pool_id": func(ctx context.Context, client *tfe.Client, id string) (string, error) {
+		p, err := client.AgentPools.Read(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return organizationName(p.Organization), nil
+	},
+	"task_id": func(ctx context.Context, client *tfe.Client, id string) (string, error) {
+		t, err := client.RunTasks.Read(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return organizationName(t.Organization), nil
+	},
+	"organization_membership_id": func(ctx context.Context, client *tfe.Client, id string) (string, error) {
+		m, err := client.OrganizationMemberships.Read(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return organizationName(m.Organization), nil
+	},
+}
+
+func organizationName(org *tfe.Organization) string {
+	if org == nil {
+		return ""
+	}
+	return org.Name
+}
+
+// useParentOrganization switches Client to the client for the organization of
+// the parent identified by id, the value of the key argument. The parent is
+// read with the provider's client, then with each organization client, until
+// one of them can read it. The organization is remembered for the parent, so
+// it's only looked up once.
+//
+// When no client can read the parent, such as when it was deleted, Client is
+// left alone, or switched to an organization client when the provider has no
+// token, so that the operation reports the API error.
+func (c *ConfiguredClient) useParentOrganization(ctx context.Context, key, id string) {
+	read, ok := parentReaders[key]
+	if !ok || id == "" || len(c.organizationClients) == 0 {
+		return
+	}
+
+	if c.parentOrganizations != nil {
+		if organization, ok := c.parentOrganizations.Load(id); ok {
+			if client, ok := c.organizationClients[organization.(string)]; ok {
+				c.Client = client
+			}
+			return
+		}
+	}
+
+	organizations := make([]string, 0, len(c.organizationClients))
+	for organization := range c.organizationClients {
+		organizations = append(organizations, organization)
+	}
+	sort.Strings(organizations)
+
+	// An empty organization stands for the provider's client.
+	candidates := organizations
+	if !c.defaultTokenMissing {
+		candidates = append([]string{""}, organizations...)
+	}
+
+	for _, candidate := range candidates {
+		client := c.Client
+		if candidate != "" {
+			client = c.organizationClients[candidate]
+		}
+
+		organization, err := read(ctx, client, id)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := c.organizationClients[organization]; !ok {
+			// The parent is in an organization without a client of its own,
+			// so keep using the client that could read it.
+			organization = candidate
+		}
+		log.Printf("[DEBUG] Using the client for organization %q of %s %s", organization, key, id)
+		if c.parentOrganizations != nil {
+			c.parentOrganizations.Store(id, organization)
+		}
+		if organization != "" {
+			c.Client = c.organizationClients[organization]
+		}
+		return
+	}
+
+	if c.defaultTokenMissing {
+		c.Client = c.organizationClients[organizations[0]]
+	}
+}
+
+// withParentOrganizationClients wraps the operations of a classic resource
+// identified by a parent, such as a workspace, instead of an organization
+// argument, so that they use the client for the organization of the parent.
+func withParentOrganizationClients(r *schema.Resource) {
+	if _, ok := r.Schema["organization"]; ok {
+		return
+	}
+
+	var keys []string
+	for _, key := range parentKeys {
+		if _, ok := r.Schema[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	r.CreateContext = withParentOrganizationClient(keys, r.CreateContext)
+	r.ReadContext = withParentOrganizationClient(keys, r.ReadContext)
+	r.UpdateContext = withParentOrganizationClient(keys, r.UpdateContext)
+	r.DeleteContext = withParentOrganizationClient(keys, r.DeleteContext)
+}
+
+func withParentOrganizationClient[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](keys []string, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if config, ok := meta.(ConfiguredClient); ok && len(config.organizationClients) > 0 {
+			for _, key := range keys {
+				if id, ok := d.Get(key).(string); ok && id != "" {
+					config.useParentOrganization(ctx, key, id)
+					break
+				}
+			}
+			meta = config
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// useDataParentOrganization is useParentOrganization for the first of keys
+// set in the Config/Plan/State data, for the framework resources identified
+// by a parent.
+func (c *ConfiguredClient) useDataParentOrganization(ctx context.Context, data AttrGettable, keys ...string) fwdiag.Diagnostics {
+	var diags fwdiag.Diagnostics
+	if len(c.organizationClients) == 0 {
+		return diags
+	}
+
+	for _, key := range keys {
+		var id types.String
+		diags.Append(data.GetAttribute(ctx, path.Root(key), &id)...)
+		if diags.HasError() {
+			return diags
+		}
+		if !id.IsNull() && !id.IsUnknown() && id.ValueString() != "" {
+			c.useParentOrganization(ctx, key, id.ValueString())
+			break
+		}
+	}
+	return diags
+}
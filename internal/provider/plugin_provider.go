@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"os"
+	"sync"
 
-	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-tfe/internal/client"
@@ -19,11 +20,16 @@ type pluginProviderServer struct {
 	providerMetaSchema *tfprotov5.Schema
 	resourceSchemas    map[string]*tfprotov5.Schema
 	dataSourceSchemas  map[string]*tfprotov5.Schema
-	tfeClient          *tfe.Client
-	organization       string
+	config             ConfiguredClient
 
 	resourceRouter
 	dataSourceRouter map[string]func(ConfiguredClient) tfprotov5.DataSourceServer
+
+	// stopCtx is canceled when the provider is stopped, which cancels the
+	// operations in progress.
+	stopMu  sync.Mutex
+	stopCtx context.Context
+	stop    context.CancelFunc
 }
 
 type errUnsupportedDataSource string
@@ -38,11 +44,71 @@ func (e errUnsupportedResource) Error() string {
 	return "unsupported resource: " + string(e)
 }
 
+type errUnsupportedEphemeralResource string
+
+func (e errUnsupportedEphemeralResource) Error() string {
+	return "unsupported ephemeral resource: " + string(e)
+}
+
+type errUnsupportedFunction string
+
+func (e errUnsupportedFunction) Error() string {
+	return "unsupported function: " + string(e)
+}
+
 type providerMeta struct {
-	token         string
-	hostname      string
-	sslSkipVerify bool
-	organization  string
+	token                       string
+	tokenFile                   string
+	hostname                    string
+	sslSkipVerify               bool
+	organization                string
+	maxRetries                  *int
+	retryWaitMin                string
+	retryWaitMax                string
+	retryServerErrors           *bool
+	caCertFile                  string
+	caCertPEM                   string
+	clientCert                  string
+	clientKey                   string
+	readCacheTTL                string
+	maxConcurrentRequests       *int
+	apiURL                      string
+	serviceOverrides            map[string]string
+	skipDiscovery               *bool
+	readOnly                    *bool
+	workloadIdentityTokenFile   string
+	workloadIdentityAuth        *bool
+	workloadIdentityExchangeURL string
+	organizationTokens          map[string]string
+	organizationTokenFiles      map[string]string
+	deletionProtection          *deletionProtection
+}
+
+func (m providerMeta) clientOptions() *client.ClientOptions {
+	return &client.ClientOptions{
+		Hostname:              m.hostname,
+		Token:                 m.token,
+		TokenFile:             m.tokenFile,
+		SSLSkipVerify:         m.sslSkipVerify,
+		MaxRetries:            m.maxRetries,
+		RetryWaitMin:          m.retryWaitMin,
+		RetryWaitMax:          m.retryWaitMax,
+		RetryServerErrors:     m.retryServerErrors,
+		CACertFile:            m.caCertFile,
+		CACertPEM:             m.caCertPEM,
+		ClientCert:            m.clientCert,
+		ClientKey:             m.clientKey,
+		ReadCacheTTL:          m.readCacheTTL,
+		MaxConcurrentRequests: m.maxConcurrentRequests,
+		APIURL:                m.apiURL,
+		ServiceOverrides:      m.serviceOverrides,
+		SkipDiscovery:         m.skipDiscovery,
+		ReadOnly:              m.readOnly,
+
+		WorkloadIdentityTokenFile:   m.workloadIdentityTokenFile,
+		WorkloadIdentityAuth:        m.workloadIdentityAuth,
+		WorkloadIdentityExchangeURL: m.workloadIdentityExchangeURL,
+	}
 }
 
 func (p *pluginProviderServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
@@ -85,7 +151,11 @@ func (p *pluginProviderServer) ConfigureProvider(ctx context.Context, req *tfpro
 		return resp, nil
 	}
 
-	tfeClient, err := client.GetClient(meta.hostname, meta.token, meta.sslSkipVerify)
+	if meta.organization == "" {
+		meta.organization = os.Getenv("TFE_ORGANIZATION")
+	}
+
+	config, err := newConfiguredClient(meta.clientOptions(), meta.organization, meta.organizationTokens, meta.organizationTokenFiles)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
@@ -95,25 +165,47 @@ func (p *pluginProviderServer) ConfigureProvider(ctx context.Context, req *tfpro
 		return resp, nil
 	}
 
-	if meta.organization == "" {
-		meta.organization = os.Getenv("TFE_ORGANIZATION")
-	}
-
-	p.tfeClient = tfeClient
-	p.organization = meta.organization
+	p.config = config
 	return resp, nil
 }
 
 func (p *pluginProviderServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	p.stopMu.Lock()
+	if p.stop != nil {
+		p.stop()
+		// Operations started after this one aren't canceled.
+		p.stopCtx, p.stop = nil, nil
+	}
+	p.stopMu.Unlock()
+
+	client.ReportAPIMetrics()
 	return &tfprotov5.StopProviderResponse{}, nil
 }
 
+// operationContext returns a context that is canceled when ctx is done or
+// when the provider is stopped, whichever happens first.
+func (p *pluginProviderServer) operationContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	p.stopMu.Lock()
+	if p.stopCtx == nil {
+		p.stopCtx, p.stop = context.WithCancel(context.Background())
+	}
+	stopCtx := p.stopCtx
+	p.stopMu.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	unregister := context.AfterFunc(stopCtx, cancel)
+	return ctx, func() {
+		unregister()
+		cancel()
+	}
+}
+
 func (p *pluginProviderServer) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
 	ds, ok := p.dataSourceRouter[req.TypeName]
 	if !ok {
 		return nil, errUnsupportedDataSource(req.TypeName)
 	}
-	return ds(ConfiguredClient{p.tfeClient, p.organization}).ValidateDataSourceConfig(ctx, req)
+	return ds(p.configuredClient()).ValidateDataSourceConfig(ctx, req)
 }
 
 func (p *pluginProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
@@ -121,7 +213,14 @@ func (p *pluginProviderServer) ReadDataSource(ctx context.Context, req *tfprotov
 	if !ok {
 		return nil, errUnsupportedDataSource(req.TypeName)
 	}
-	return ds(ConfiguredClient{p.tfeClient, p.organization}).ReadDataSource(ctx, req)
+
+	ctx, cancel := p.operationContext(ctx)
+	defer cancel()
+	return ds(p.configuredClient()).ReadDataSource(ctx, req)
+}
+
+func (p *pluginProviderServer) configuredClient() ConfiguredClient {
+	return p.config
 }
 
 type resourceRouter map[string]tfprotov5.ResourceServer
@@ -174,6 +273,57 @@ func (r resourceRouter) ImportResourceState(ctx context.Context, req *tfprotov5.
 	return res.ImportResourceState(ctx, req)
 }
 
+func (r resourceRouter) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	res, ok := r[req.TargetTypeName]
+	if !ok {
+		return nil, errUnsupportedResource(req.TargetTypeName)
+	}
+	return res.MoveResourceState(ctx, req)
+}
+
+func (r resourceRouter) UpgradeResourceIdentity(ctx context.Context, req *tfprotov5.UpgradeResourceIdentityRequest) (*tfprotov5.UpgradeResourceIdentityResponse, error) {
+	res, ok := r[req.TypeName]
+	if !ok {
+		return nil, errUnsupportedResource(req.TypeName)
+	}
+	return res.UpgradeResourceIdentity(ctx, req)
+}
+
+// This server has no resource identities, functions or ephemeral resources;
+// the framework provider implements them.
+
+func (p *pluginProviderServer) GetResourceIdentitySchemas(ctx context.Context, req *tfprotov5.GetResourceIdentitySchemasRequest) (*tfprotov5.GetResourceIdentitySchemasResponse, error) {
+	return &tfprotov5.GetResourceIdentitySchemasResponse{
+		IdentitySchemas: map[string]*tfprotov5.ResourceIdentitySchema{},
+	}, nil
+}
+
+func (p *pluginProviderServer) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{
+		Functions: map[string]*tfprotov5.Function{},
+	}, nil
+}
+
+func (p *pluginProviderServer) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	return nil, errUnsupportedFunction(req.Name)
+}
+
+func (p *pluginProviderServer) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	return nil, errUnsupportedEphemeralResource(req.TypeName)
+}
+
+func (p *pluginProviderServer) OpenEphemeralResource(ctx context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	return nil, errUnsupportedEphemeralResource(req.TypeName)
+}
+
+func (p *pluginProviderServer) RenewEphemeralResource(ctx context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	return nil, errUnsupportedEphemeralResource(req.TypeName)
+}
+
+func (p *pluginProviderServer) CloseEphemeralResource(ctx context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	return nil, errUnsupportedEphemeralResource(req.TypeName)
+}
+
 // PluginProviderServer returns the implementation of an interface for a lower
 // level usage of the Provider to Terraform protocol.
 // This relies on the terraform-plugin-go library, which provides low level
@@ -195,6 +345,12 @@ func PluginProviderServer() tfprotov5.ProviderServer {
 						Description: descriptions["token"],
 						Optional:    true,
 					},
+					{
+						Name:        "token_file",
+						Type:        tftypes.String,
+						Description: descriptions["token_file"],
+						Optional:    true,
+					},
 					{
 						Name:        "ssl_skip_verify",
 						Type:        tftypes.Bool,
@@ -207,6 +363,163 @@ func PluginProviderServer() tfprotov5.ProviderServer {
 						Description: descriptions["organization"],
 						Optional:    true,
 					},
+					{
+						Name:        "max_retries",
+						Type:        tftypes.Number,
+						Description: descriptions["max_retries"],
+						Optional:    true,
+					},
+					{
+						Name:        "retry_wait_min",
+						Type:        tftypes.String,
+						Description: descriptions["retry_wait_min"],
+						Optional:    true,
+					},
+					{
+						Name:        "retry_wait_max",
+						Type:        tftypes.String,
+						Description: descriptions["retry_wait_max"],
+						Optional:    true,
+					},
+					{
+						Name:        "retry_server_errors",
+						Type:        tftypes.Bool,
+						Description: descriptions["retry_server_errors"],
+						Optional:    true,
+					},
+					{
+						Name:        "ca_cert_file",
+						Type:        tftypes.String,
+						Description: descriptions["ca_cert_file"],
+						Optional:    true,
+					},
+					{
+						Name:        "ca_cert_pem",
+						Type:        tftypes.String,
+						Description: descriptions["ca_cert_pem"],
+						Optional:    true,
+					},
+					{
+						Name:        "client_cert",
+						Type:        tftypes.String,
+						Description: descriptions["client_cert"],
+						Optional:    true,
+					},
+					{
+						Name:        "client_key",
+						Type:        tftypes.String,
+						Description: descriptions["client_key"],
+						Optional:    true,
+						Sensitive:   true,
+					},
+					{
+						Name:        "read_cache_ttl",
+						Type:        tftypes.String,
+						Description: descriptions["read_cache_ttl"],
+						Optional:    true,
+					},
+					{
+						Name:        "max_concurrent_requests",
+						Type:        tftypes.Number,
+						Description: descriptions["max_concurrent_requests"],
+						Optional:    true,
+					},
+					{
+						Name:        "api_url",
+						Type:        tftypes.String,
+						Description: descriptions["api_url"],
+						Optional:    true,
+					},
+					{
+						Name:        "service_overrides",
+						Type:        tftypes.Map{ElementType: tftypes.String},
+						Description: descriptions["service_overrides"],
+						Optional:    true,
+					},
+					{
+						Name:        "skip_discovery",
+						Type:        tftypes.Bool,
+						Description: descriptions["skip_discovery"],
+						Optional:    true,
+					},
+					{
+						Name:        "read_only",
+						Type:        tftypes.Bool,
+						Description: descriptions["read_only"],
+						Optional:    true,
+					},
+					{
+						Name:        "workload_identity_token_file",
+						Type:        tftypes.String,
+						Description: descriptions["workload_identity_token_file"],
+						Optional:    true,
+					},
+					{
+						Name:        "workload_identity_auth",
+						Type:        tftypes.Bool,
+						Description: descriptions["workload_identity_auth"],
+						Optional:    true,
+					},
+					{
+						Name:        "workload_identity_exchange_url",
+						Type:        tftypes.String,
+						Description: descriptions["workload_identity_exchange_url"],
+						Optional:    true,
+					},
+					{
+						Name:        "organization_tokens",
+						Type:        tftypes.Map{ElementType: tftypes.String},
+						Description: descriptions["organization_tokens"],
+						Optional:    true,
+						Sensitive:   true,
+					},
+					{
+						Name:        "organization_token_files",
+						Type:        tftypes.Map{ElementType: tftypes.String},
+						Description: descriptions["organization_token_files"],
+						Optional:    true,
+					},
+				},
+				// Default tags only apply to classic resources, and deletion
+				// protection is checked by the server wrapping the muxed servers,
+				// but they must all share the same provider schema.
+				BlockTypes: []*tfprotov5.SchemaNestedBlock{
+					{
+						TypeName: "deletion_protection",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+						Block: &tfprotov5.SchemaBlock{
+							Description: descriptions["deletion_protection"],
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:        "resource_types",
+									Type:        tftypes.Set{ElementType: tftypes.String},
+									Description: descriptions["deletion_protection.resource_types"],
+									Required:    true,
+								},
+								{
+									Name:        "name_patterns",
+									Type:        tftypes.List{ElementType: tftypes.String},
+									Description: descriptions["deletion_protection.name_patterns"],
+									Optional:    true,
+								},
+							},
+						},
+					},
+					{
+						TypeName: "default_tags",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+						Block: &tfprotov5.SchemaBlock{
+							Description: descriptions["default_tags"],
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:        "tag_names",
+									Type:        tftypes.Set{ElementType: tftypes.String},
+									Description: descriptions["default_tags.tag_names"],
+									Optional:    true,
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -263,10 +576,37 @@ func retrieveProviderMeta(req *tfprotov5.ConfigureProviderRequest) (providerMeta
 	config := req.Config
 	val, err := config.Unmarshal(tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
-			"hostname":        tftypes.String,
-			"token":           tftypes.String,
-			"ssl_skip_verify": tftypes.Bool,
-			"organization":    tftypes.String,
+			"hostname":                       tftypes.String,
+			"token":                          tftypes.String,
+			"token_file":                     tftypes.String,
+			"ssl_skip_verify":                tftypes.Bool,
+			"organization":                   tftypes.String,
+			"max_retries":                    tftypes.Number,
+			"retry_wait_min":                 tftypes.String,
+			"retry_wait_max":                 tftypes.String,
+			"retry_server_errors":            tftypes.Bool,
+			"ca_cert_file":                   tftypes.String,
+			"ca_cert_pem":                    tftypes.String,
+			"client_cert":                    tftypes.String,
+			"client_key":                     tftypes.String,
+			"read_cache_ttl":                 tftypes.String,
+			"max_concurrent_requests":        tftypes.Number,
+			"api_url":                        tftypes.String,
+			"service_overrides":              tftypes.Map{ElementType: tftypes.String},
+			"skip_discovery":                 tftypes.Bool,
+			"read_only":                      tftypes.Bool,
+			"workload_identity_token_file":   tftypes.String,
+			"workload_identity_auth":         tftypes.Bool,
+			"workload_identity_exchange_url": tftypes.String,
+			"organization_tokens":            tftypes.Map{ElementType: tftypes.String},
+			"organization_token_files":       tftypes.Map{ElementType: tftypes.String},
+			"default_tags": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"tag_names": tftypes.Set{ElementType: tftypes.String},
+			}}},
+			"deletion_protection": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"resource_types": tftypes.Set{ElementType: tftypes.String},
+				"name_patterns":  tftypes.List{ElementType: tftypes.String},
+			}}},
 		}})
 
 	if err != nil {
@@ -308,6 +648,120 @@ func retrieveProviderMeta(req *tfprotov5.ConfigureProviderRequest) (providerMeta
 		}
 	}
 
+	if !valMap["max_retries"].IsNull() {
+		var maxRetries big.Float
+		err = valMap["max_retries"].As(&maxRetries)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the max_retries value to number: %w", err)
+		}
+		v, _ := maxRetries.Int64()
+		retries := int(v)
+		meta.maxRetries = &retries
+	}
+	if !valMap["max_concurrent_requests"].IsNull() {
+		var maxConcurrent big.Float
+		err = valMap["max_concurrent_requests"].As(&maxConcurrent)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the max_concurrent_requests value to number: %w", err)
+		}
+		v, _ := maxConcurrent.Int64()
+		limit := int(v)
+		meta.maxConcurrentRequests = &limit
+	}
+	if !valMap["retry_wait_min"].IsNull() {
+		err = valMap["retry_wait_min"].As(&meta.retryWaitMin)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the retry_wait_min value to string: %w", err)
+		}
+	}
+	if !valMap["retry_wait_max"].IsNull() {
+		err = valMap["retry_wait_max"].As(&meta.retryWaitMax)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the retry_wait_max value to string: %w", err)
+		}
+	}
+	if !valMap["retry_server_errors"].IsNull() {
+		var retryServerErrors bool
+		err = valMap["retry_server_errors"].As(&retryServerErrors)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the retry_server_errors value to boolean: %w", err)
+		}
+		meta.retryServerErrors = &retryServerErrors
+	}
+	if !valMap["skip_discovery"].IsNull() {
+		var skipDiscovery bool
+		err = valMap["skip_discovery"].As(&skipDiscovery)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the skip_discovery value to boolean: %w", err)
+		}
+		meta.skipDiscovery = &skipDiscovery
+	}
+	if !valMap["service_overrides"].IsNull() {
+		var overrides map[string]tftypes.Value
+		err = valMap["service_overrides"].As(&overrides)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the service_overrides value to map: %w", err)
+		}
+		meta.serviceOverrides = make(map[string]string, len(overrides))
+		for id, v := range overrides {
+			var u string
+			err = v.As(&u)
+			if err != nil {
+				return meta, fmt.Errorf("failed to set the service_overrides value for %q to string: %w", id, err)
+			}
+			meta.serviceOverrides[id] = u
+		}
+	}
+	if !valMap["read_only"].IsNull() {
+		var readOnly bool
+		err = valMap["read_only"].As(&readOnly)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the read_only value to boolean: %w", err)
+		}
+		meta.readOnly = &readOnly
+	}
+	if !valMap["workload_identity_auth"].IsNull() {
+		var workloadIdentityAuth bool
+		err = valMap["workload_identity_auth"].As(&workloadIdentityAuth)
+		if err != nil {
+			return meta, fmt.Errorf("failed to set the workload_identity_auth value to boolean: %w", err)
+		}
+		meta.workloadIdentityAuth = &workloadIdentityAuth
+	}
+	if !valMap["deletion_protection"].IsNull() {
+		meta.deletionProtection, err = retrieveDeletionProtection(valMap["deletion_protection"])
+		if err != nil {
+			return meta, err
+		}
+	}
+	meta.organizationTokens, err = retrieveStringMap(valMap, "organization_tokens")
+	if err != nil {
+		return meta, err
+	}
+	meta.organizationTokenFiles, err = retrieveStringMap(valMap, "organization_token_files")
+	if err != nil {
+		return meta, err
+	}
+	for name, dst := range map[string]*string{
+		"token_file":     &meta.tokenFile,
+		"ca_cert_file":   &meta.caCertFile,
+		"ca_cert_pem":    &meta.caCertPEM,
+		"client_cert":    &meta.clientCert,
+		"client_key":     &meta.clientKey,
+		"read_cache_ttl": &meta.readCacheTTL,
+		"api_url":        &meta.apiURL,
+
+		"workload_identity_token_file":   &meta.workloadIdentityTokenFile,
+		"workload_identity_exchange_url": &meta.workloadIdentityExchangeURL,
+	} {
+		if !valMap[name].IsNull() {
+			err = valMap[name].As(dst)
+			if err != nil {
+				return meta, fmt.Errorf("failed to set the %s value to string: %w", name, err)
+			}
+		}
+	}
+
 	meta.hostname = hostname
 	meta.token = token
 	meta.sslSkipVerify = sslSkipVerify
@@ -315,3 +769,71 @@ func retrieveProviderMeta(req *tfprotov5.ConfigureProviderRequest) (providerMeta
 
 	return meta, nil
 }
+
+// retrieveDeletionProtection decodes the deletion_protection block, which is
+// nil when the block is empty.
+func retrieveDeletionProtection(val tftypes.Value) (*deletionProtection, error) {
+	var blocks []tftypes.Value
+	if err := val.As(&blocks); err != nil {
+		return nil, fmt.Errorf("failed to set the deletion_protection value to list: %w", err)
+	}
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := blocks[0].As(&attrs); err != nil {
+		return nil, fmt.Errorf("failed to set the deletion_protection value to map: %w", err)
+	}
+
+	protection := &deletionProtection{resourceTypes: map[string]bool{}}
+
+	var resourceTypes []tftypes.Value
+	if err := attrs["resource_types"].As(&resourceTypes); err != nil {
+		return nil, fmt.Errorf("failed to set the deletion_protection resource_types value to set: %w", err)
+	}
+	for _, v := range resourceTypes {
+		var resourceType string
+		if err := v.As(&resourceType); err != nil {
+			return nil, fmt.Errorf("failed to set the deletion_protection resource_types value to string: %w", err)
+		}
+		protection.resourceTypes[resourceType] = true
+	}
+
+	if !attrs["name_patterns"].IsNull() {
+		var namePatterns []tftypes.Value
+		if err := attrs["name_patterns"].As(&namePatterns); err != nil {
+			return nil, fmt.Errorf("failed to set the deletion_protection name_patterns value to list: %w", err)
+		}
+		for _, v := range namePatterns {
+			var pattern string
+			if err := v.As(&pattern); err != nil {
+				return nil, fmt.Errorf("failed to set the deletion_protection name_patterns value to string: %w", err)
+			}
+			protection.namePatterns = append(protection.namePatterns, pattern)
+		}
+	}
+
+	return protection, nil
+}
+
+func retrieveStringMap(valMap map[string]tftypes.Value, name string) (map[string]string, error) {
+	if valMap[name].IsNull() {
+		return nil, nil
+	}
+
+	var values map[string]tftypes.Value
+	if err := valMap[name].As(&values); err != nil {
+		return nil, fmt.Errorf("failed to set the %s value to map: %w", name, err)
+	}
+
+	result := make(map[string]string, len(values))
+	for key, v := range values {
+		var value string
+		if err := v.As(&value); err != nil {
+			return nil, fmt.Errorf("failed to set the %s value for %q to string: %w", name, key, err)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
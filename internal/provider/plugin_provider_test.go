@@ -4,8 +4,11 @@
 package provider
 
 import (
+	"context"
 	"errors"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -47,32 +50,15 @@ func TestPluginProvider_providerMeta(t *testing.T) {
 	}
 
 	for name, tc := range cases {
-		config, err := tfprotov5.NewDynamicValue(tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"hostname":        tftypes.String,
-				"token":           tftypes.String,
-				"ssl_skip_verify": tftypes.Bool,
-				"organization":    tftypes.String,
-			},
-		}, tftypes.NewValue(tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"hostname":        tftypes.String,
-				"token":           tftypes.String,
-				"ssl_skip_verify": tftypes.Bool,
-				"organization":    tftypes.String,
-			},
-		}, map[string]tftypes.Value{
+		config := testProviderConfig(t, map[string]tftypes.Value{
 			"hostname":        tftypes.NewValue(tftypes.String, tc.hostname),
 			"token":           tftypes.NewValue(tftypes.String, tc.token),
 			"ssl_skip_verify": tftypes.NewValue(tftypes.Bool, tc.sslSkipVerify),
 			"organization":    tftypes.NewValue(tftypes.String, tc.organization),
-		}))
-		if err != nil {
-			t.Fatal(err.Error())
-		}
+		})
 
 		req := &tfprotov5.ConfigureProviderRequest{
-			Config: &config,
+			Config: config,
 		}
 
 		meta, err := retrieveProviderMeta(req)
@@ -111,3 +97,177 @@ func TestPluginProvider_providerMeta(t *testing.T) {
 		}
 	}
 }
+
+func TestPluginProvider_providerMetaRetry(t *testing.T) {
+	config := testProviderConfig(t, map[string]tftypes.Value{
+		"max_retries":         tftypes.NewValue(tftypes.Number, 0),
+		"retry_wait_min":      tftypes.NewValue(tftypes.String, "1s"),
+		"retry_wait_max":      tftypes.NewValue(tftypes.String, "1m"),
+		"retry_server_errors": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	meta, err := retrieveProviderMeta(&tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if meta.maxRetries == nil || *meta.maxRetries != 0 {
+		t.Fatalf("expected max_retries to be set to 0, got %v", meta.maxRetries)
+	}
+	if meta.retryWaitMin != "1s" || meta.retryWaitMax != "1m" {
+		t.Fatalf("expected retry waits of 1s and 1m, got %q and %q", meta.retryWaitMin, meta.retryWaitMax)
+	}
+	if meta.retryServerErrors == nil || *meta.retryServerErrors {
+		t.Fatalf("expected retry_server_errors to be set to false, got %v", meta.retryServerErrors)
+	}
+
+	config = testProviderConfig(t, nil)
+	meta, err = retrieveProviderMeta(&tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if meta.maxRetries != nil || meta.retryServerErrors != nil {
+		t.Fatal("expected unset retry settings to be left for the client to resolve")
+	}
+}
+
+func TestPluginProvider_providerMetaRequests(t *testing.T) {
+	config := testProviderConfig(t, map[string]tftypes.Value{
+		"read_cache_ttl":          tftypes.NewValue(tftypes.String, "10s"),
+		"max_concurrent_requests": tftypes.NewValue(tftypes.Number, 4),
+	})
+
+	meta, err := retrieveProviderMeta(&tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := meta.clientOptions().ReadCacheTTL; got != "10s" {
+		t.Fatalf("expected read_cache_ttl to be passed to the client, got %q", got)
+	}
+	if got := meta.clientOptions().MaxConcurrentRequests; got == nil || *got != 4 {
+		t.Fatalf("expected max_concurrent_requests to be passed to the client, got %v", got)
+	}
+}
+
+func TestPluginProvider_providerMetaDiscovery(t *testing.T) {
+	config := testProviderConfig(t, map[string]tftypes.Value{
+		"api_url": tftypes.NewValue(tftypes.String, "https://tfe.example.com/api/v2/"),
+		"service_overrides": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"modules.v1": tftypes.NewValue(tftypes.String, "https://tfe.example.com/api/registry/v1/modules/"),
+		}),
+		"skip_discovery": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	meta, err := retrieveProviderMeta(&tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	opts := meta.clientOptions()
+	if opts.APIURL != "https://tfe.example.com/api/v2/" {
+		t.Fatalf("expected api_url to be passed to the client, got %q", opts.APIURL)
+	}
+	if opts.ServiceOverrides["modules.v1"] != "https://tfe.example.com/api/registry/v1/modules/" {
+		t.Fatalf("expected service_overrides to be passed to the client, got %v", opts.ServiceOverrides)
+	}
+	if opts.SkipDiscovery == nil || !*opts.SkipDiscovery {
+		t.Fatalf("expected skip_discovery to be passed to the client, got %v", opts.SkipDiscovery)
+	}
+}
+
+func TestPluginProvider_providerMetaOrganizationTokens(t *testing.T) {
+	config := testProviderConfig(t, map[string]tftypes.Value{
+		"organization_tokens": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"hashicorp": tftypes.NewValue(tftypes.String, "secret-token"),
+		}),
+		"organization_token_files": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"acme": tftypes.NewValue(tftypes.String, "/run/secrets/acme-token"),
+		}),
+	})
+
+	meta, err := retrieveProviderMeta(&tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"hashicorp": "secret-token"}
+	if !reflect.DeepEqual(meta.organizationTokens, expected) {
+		t.Fatalf("expected organization_tokens to be %v, got %v", expected, meta.organizationTokens)
+	}
+
+	expected = map[string]string{"acme": "/run/secrets/acme-token"}
+	if !reflect.DeepEqual(meta.organizationTokenFiles, expected) {
+		t.Fatalf("expected organization_token_files to be %v, got %v", expected, meta.organizationTokenFiles)
+	}
+}
+
+func TestPluginProvider_providerMetaWorkloadIdentity(t *testing.T) {
+	config := testProviderConfig(t, map[string]tftypes.Value{
+		"workload_identity_token_file":   tftypes.NewValue(tftypes.String, "/run/secrets/jwt"),
+		"workload_identity_auth":         tftypes.NewValue(tftypes.Bool, false),
+		"workload_identity_exchange_url": tftypes.NewValue(tftypes.String, "https://sts.example.com/exchange"),
+	})
+
+	meta, err := retrieveProviderMeta(&tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	opts := meta.clientOptions()
+	if opts.WorkloadIdentityTokenFile != "/run/secrets/jwt" {
+		t.Fatalf("expected workload_identity_token_file to be set, got %q", opts.WorkloadIdentityTokenFile)
+	}
+	if opts.WorkloadIdentityAuth == nil || *opts.WorkloadIdentityAuth {
+		t.Fatalf("expected workload_identity_auth to be false, got %v", opts.WorkloadIdentityAuth)
+	}
+	if opts.WorkloadIdentityExchangeURL != "https://sts.example.com/exchange" {
+		t.Fatalf("expected workload_identity_exchange_url to be set, got %q", opts.WorkloadIdentityExchangeURL)
+	}
+}
+
+func TestPluginProvider_stopCancelsOperations(t *testing.T) {
+	p := PluginProviderServer().(*pluginProviderServer)
+
+	ctx, cancel := p.operationContext(context.Background())
+	defer cancel()
+
+	if _, err := p.StopProvider(context.Background(), &tfprotov5.StopProviderRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected the operation in progress to be canceled when the provider stops")
+	}
+
+	next, cancelNext := p.operationContext(context.Background())
+	defer cancelNext()
+	if next.Err() != nil {
+		t.Fatalf("expected operations started after the provider stopped not to be canceled, got %v", next.Err())
+	}
+}
+
+// testProviderConfig builds a provider configuration matching the schema of
+// the plugin provider, using null for every attribute not given in values.
+func testProviderConfig(t *testing.T, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	configType := PluginProviderServer().(*pluginProviderServer).providerSchema.ValueType().(tftypes.Object)
+
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, attrs))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return &config
+}
@@ -6,7 +6,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"os"
+	"sync"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,29 +30,167 @@ var (
 type ConfiguredClient struct {
 	Client       *tfe.Client
 	Organization string
+
+	// organizationClients are authenticated with the tokens set in
+	// organization_tokens and organization_token_files, by organization name.
+	organizationClients map[string]*tfe.Client
+
+	// defaultTokenMissing is set when no token is configured for the
+	// organizations without a client in organizationClients. Client then fails
+	// every request.
+	defaultTokenMissing bool
+
+	// parentOrganizations remembers the organization of the parents looked up
+	// by useParentOrganization, by parent ID. It's shared by the copies of the
+	// ConfiguredClient.
+	parentOrganizations *sync.Map
+
+	// defaultTagNames are added to the tags of every workspace.
+	defaultTagNames []string
+}
+
+// useOrganization switches Client to the client authenticated for
+// organization, when organization_tokens or organization_token_files has a
+// token for it. It returns an error when the organization has no token at all.
+func (c *ConfiguredClient) useOrganization(organization string) error {
+	if client, ok := c.organizationClients[organization]; ok {
+		c.Client = client
+		return nil
+	}
+	if c.defaultTokenMissing && organization != "" {
+		return fmt.Errorf("no token is configured for organization %q: set the token argument, or add %q to organization_tokens or organization_token_files", organization, organization)
+	}
+	return nil
+}
+
+// newConfiguredClient configures the default client and the clients for
+// organization_tokens and organization_token_files. The default token is only
+// required when neither of them is set.
+func newConfiguredClient(opts *client.ClientOptions, organization string, tokens, tokenFiles map[string]string) (ConfiguredClient, error) {
+	tfeClient, err := client.GetClient(opts)
+	defaultTokenMissing := errors.Is(err, client.ErrMissingAuthToken) && len(tokens)+len(tokenFiles) > 0
+	if defaultTokenMissing {
+		log.Printf("[DEBUG] No default token is configured, only organizations with a token in organization_tokens or organization_token_files can be used")
+		tfeClient, err = client.GetClientWithoutToken(opts)
+	}
+	if err != nil {
+		return ConfiguredClient{}, err
+	}
+
+	organizationClients, err := client.GetOrganizationClients(opts, tokens, tokenFiles)
+	if err != nil {
+		return ConfiguredClient{}, err
+	}
+
+	return ConfiguredClient{
+		Client:              tfeClient,
+		Organization:        organization,
+		organizationClients: organizationClients,
+		defaultTokenMissing: defaultTokenMissing,
+		parentOrganizations: &sync.Map{},
+	}, nil
 }
 
-func (c ConfiguredClient) schemaOrDefaultOrganization(resource *schema.ResourceData) (string, error) {
+// schemaOrDefaultOrganization returns the organization of the resource,
+// defaulting to the provider configuration, and switches Client to the
+// client for that organization.
+func (c *ConfiguredClient) schemaOrDefaultOrganization(resource *schema.ResourceData) (string, error) {
 	return c.schemaOrDefaultOrganizationKey(resource, "organization")
 }
 
-func (c ConfiguredClient) schemaOrDefaultOrganizationKey(resource *schema.ResourceData, key string) (string, error) {
-	schemaOrg, got := resource.GetOk(key)
-	if got {
-		return schemaOrg.(string), nil
+func (c *ConfiguredClient) schemaOrDefaultOrganizationKey(resource *schema.ResourceData, key string) (string, error) {
+	organization := c.Organization
+	if schemaOrg, got := resource.GetOk(key); got {
+		organization = schemaOrg.(string)
 	}
-	if c.Organization == "" {
+	if organization == "" {
 		return "", errMissingOrganization
 	}
-	return c.Organization, nil
+
+	if err := c.useOrganization(organization); err != nil {
+		return "", err
+	}
+	return organization, nil
+}
+
+// withOrganizationClients wraps the operations of a classic resource with an
+// organization argument, so that the ones which don't resolve the organization
+// themselves, such as reads of an existing resource, use its client too.
+func withOrganizationClients(r *schema.Resource) {
+	if _, ok := r.Schema["organization"]; !ok {
+		return
+	}
+
+	r.CreateContext = withOrganizationClient(r.CreateContext)
+	r.ReadContext = withOrganizationClient(r.ReadContext)
+	r.UpdateContext = withOrganizationClient(r.UpdateContext)
+	r.DeleteContext = withOrganizationClient(r.DeleteContext)
+}
+
+func withOrganizationClient[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if config, ok := meta.(ConfiguredClient); ok && len(config.organizationClients) > 0 {
+			// Resources without an organization are reported by the operation.
+			if _, err := config.schemaOrDefaultOrganization(d); err != nil && !errors.Is(err, errMissingOrganization) {
+				return diag.FromErr(err)
+			}
+			meta = config
+		}
+		return f(ctx, d, meta)
+	}
 }
 
-// ctx is used as default context.Context when making TFE calls.
-var ctx = context.Background()
+// resourceTimeouts returns the timeouts of a classic resource. Its update
+// timeout is only set when the resource can be updated. They default to zero,
+// for operations without a deadline, see withTimeouts.
+func resourceTimeouts(update bool) *schema.ResourceTimeout {
+	timeouts := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(time.Duration(0)),
+		Read:   schema.DefaultTimeout(time.Duration(0)),
+		Delete: schema.DefaultTimeout(time.Duration(0)),
+	}
+	if update {
+		timeouts.Update = schema.DefaultTimeout(time.Duration(0))
+	}
+	return timeouts
+}
+
+// withTimeouts makes the operations of a classic resource run without a
+// deadline, unless its timeouts block sets one. The SDK gives the operations
+// set with CreateContext and the like a deadline in any case, so they're set
+// with CreateWithoutTimeout and the like instead.
+func withTimeouts(r *schema.Resource) {
+	r.CreateWithoutTimeout, r.CreateContext = withTimeout(schema.TimeoutCreate, r.CreateContext), nil
+	r.ReadWithoutTimeout, r.ReadContext = withTimeout(schema.TimeoutRead, r.ReadContext), nil
+	r.UpdateWithoutTimeout, r.UpdateContext = withTimeout(schema.TimeoutUpdate, r.UpdateContext), nil
+	r.DeleteWithoutTimeout, r.DeleteContext = withTimeout(schema.TimeoutDelete, r.DeleteContext), nil
+}
+
+func withTimeout[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](key string, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		// Note that the SDK reports its own 20 minute default when reading a
+		// resource whose state has no timeouts, such as one last applied by
+		// an earlier version of the provider.
+		if timeout := d.Timeout(key); timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return f(ctx, d, meta)
+	}
+}
 
 // Provider returns a schema.Provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		// Note that defaults and fallbacks which are usually handled by DefaultFunc here are
 		// instead handled when fetching a HCP Terraform and Terraform Enterprise client in getClient(). This is because the this
 		// provider is actually two muxed providers which must respect the same logic for fetching
@@ -66,6 +208,12 @@ func Provider() *schema.Provider {
 				Description: descriptions["token"],
 			},
 
+			"token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["token_file"],
+			},
+
 			"ssl_skip_verify": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -77,6 +225,165 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: descriptions["organization"],
 			},
+
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: descriptions["max_retries"],
+			},
+
+			"retry_wait_min": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["retry_wait_min"],
+			},
+
+			"retry_wait_max": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["retry_wait_max"],
+			},
+
+			"retry_server_errors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["retry_server_errors"],
+			},
+
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["ca_cert_file"],
+			},
+
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["ca_cert_pem"],
+			},
+
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["client_cert"],
+			},
+
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["client_key"],
+			},
+
+			"read_cache_ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["read_cache_ttl"],
+			},
+
+			"max_concurrent_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: descriptions["max_concurrent_requests"],
+			},
+
+			"api_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["api_url"],
+			},
+
+			"service_overrides": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["service_overrides"],
+			},
+
+			"skip_discovery": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["skip_discovery"],
+			},
+
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["read_only"],
+			},
+
+			"workload_identity_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["workload_identity_token_file"],
+			},
+
+			"workload_identity_auth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["workload_identity_auth"],
+			},
+
+			"workload_identity_exchange_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["workload_identity_exchange_url"],
+			},
+
+			"organization_tokens": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["organization_tokens"],
+			},
+
+			"organization_token_files": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["organization_token_files"],
+			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["default_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_names": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions["default_tags.tag_names"],
+						},
+					},
+				},
+			},
+
+			"deletion_protection": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["deletion_protection"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions["deletion_protection.resource_types"],
+						},
+						"name_patterns": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions["deletion_protection.name_patterns"],
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -153,6 +460,14 @@ func Provider() *schema.Provider {
 		},
 		ConfigureContextFunc: configure(),
 	}
+
+	for _, r := range p.ResourcesMap {
+		withOrganizationClients(r)
+		withParentOrganizationClients(r)
+		withTimeouts(r)
+	}
+
+	return p
 }
 
 func configure() schema.ConfigureContextFunc {
@@ -162,31 +477,151 @@ func configure() schema.ConfigureContextFunc {
 			providerOrganization = os.Getenv("TFE_ORGANIZATION")
 		}
 
-		tfeClient, err := configureClient(rd)
+		configuredClient, err := newConfiguredClient(
+			clientOptions(rd),
+			providerOrganization,
+			stringMap(rd.Get("organization_tokens")),
+			stringMap(rd.Get("organization_token_files")),
+		)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		return ConfiguredClient{
-			tfeClient,
-			providerOrganization,
-		}, nil
+		var defaultTagNames []string
+		if v, ok := rd.GetOk("default_tags.0.tag_names"); ok {
+			for _, t := range v.(*schema.Set).List() {
+				tagName := t.(string)
+				if !validTagName(tagName) {
+					return nil, diag.Errorf("%q in default_tags is not a valid tag name. Tag must be one or more characters; can include lowercase letters, numbers, colons, hyphens, and underscores; and must begin and end with a letter or number", tagName)
+				}
+				defaultTagNames = append(defaultTagNames, tagName)
+			}
+		}
+
+		configuredClient.defaultTagNames = defaultTagNames
+		return configuredClient, nil
+	}
+}
+
+func stringMap(v interface{}) map[string]string {
+	m := make(map[string]string)
+	for k, v := range v.(map[string]interface{}) {
+		m[k] = v.(string)
 	}
+	return m
 }
 
-func configureClient(d *schema.ResourceData) (*tfe.Client, error) {
-	hostname := d.Get("hostname").(string)
-	token := d.Get("token").(string)
-	insecure := d.Get("ssl_skip_verify").(bool)
+func clientOptions(d *schema.ResourceData) *client.ClientOptions {
+	opts := &client.ClientOptions{
+		Hostname:      d.Get("hostname").(string),
+		Token:         d.Get("token").(string),
+		TokenFile:     d.Get("token_file").(string),
+		SSLSkipVerify: d.Get("ssl_skip_verify").(bool),
+		RetryWaitMin:  d.Get("retry_wait_min").(string),
+		RetryWaitMax:  d.Get("retry_wait_max").(string),
+		CACertFile:    d.Get("ca_cert_file").(string),
+		CACertPEM:     d.Get("ca_cert_pem").(string),
+		ClientCert:    d.Get("client_cert").(string),
+		ClientKey:     d.Get("client_key").(string),
+		ReadCacheTTL:  d.Get("read_cache_ttl").(string),
+		APIURL:        d.Get("api_url").(string),
+
+		WorkloadIdentityTokenFile:   d.Get("workload_identity_token_file").(string),
+		WorkloadIdentityExchangeURL: d.Get("workload_identity_exchange_url").(string),
+	}
+
+	if v, ok := d.GetOk("service_overrides"); ok {
+		opts.ServiceOverrides = make(map[string]string)
+		for id, u := range v.(map[string]interface{}) {
+			opts.ServiceOverrides[id] = u.(string)
+		}
+	}
+
+	if v, ok := d.GetOkExists("max_retries"); ok {
+		maxRetries := v.(int)
+		opts.MaxRetries = &maxRetries
+	}
+	if v, ok := d.GetOkExists("retry_server_errors"); ok {
+		retryServerErrors := v.(bool)
+		opts.RetryServerErrors = &retryServerErrors
+	}
+	if v, ok := d.GetOkExists("max_concurrent_requests"); ok {
+		maxConcurrentRequests := v.(int)
+		opts.MaxConcurrentRequests = &maxConcurrentRequests
+	}
+	if v, ok := d.GetOkExists("skip_discovery"); ok {
+		skipDiscovery := v.(bool)
+		opts.SkipDiscovery = &skipDiscovery
+	}
+	if v, ok := d.GetOkExists("read_only"); ok {
+		readOnly := v.(bool)
+		opts.ReadOnly = &readOnly
+	}
+	if v, ok := d.GetOkExists("workload_identity_auth"); ok {
+		workloadIdentityAuth := v.(bool)
+		opts.WorkloadIdentityAuth = &workloadIdentityAuth
+	}
 
-	return client.GetClient(hostname, token, insecure)
+	return opts
 }
 
 var descriptions = map[string]string{
 	"hostname": "The Terraform Enterprise hostname to connect to. Defaults to app.terraform.io.",
 	"token": "The token used to authenticate with Terraform Enterprise. We recommend omitting\n" +
 		"the token which can be set as credentials in the CLI config file.",
+	"token_file": "Path to a file containing the token used to authenticate with Terraform\n" +
+		"Enterprise. The file is read again when the token is rejected, so it can be rotated.",
 	"ssl_skip_verify": "Whether or not to skip certificate verifications.",
 	"organization": "The organization to apply to a resource if one is not defined on\n" +
 		"the resource itself",
+	"max_retries": "The maximum number of times a rate limited request, or a request that\n" +
+		"failed with a server error, is retried. Defaults to 30.",
+	"retry_wait_min": "The minimum time to wait between retries when the server doesn't\n" +
+		"specify one, as a duration string such as \"100ms\". Defaults to 100ms.",
+	"retry_wait_max": "The maximum time to wait between retries when the server doesn't\n" +
+		"specify one, as a duration string such as \"30s\". Defaults to 400ms.",
+	"retry_server_errors": "Whether or not to retry requests that failed with a server error.\n" +
+		"Defaults to true. When set to true explicitly, network errors are retried as well.",
+	"ca_cert_file": "Path to a PEM encoded bundle of certificate authorities to trust in\n" +
+		"addition to the system certificate pool.",
+	"ca_cert_pem": "PEM encoded certificate authorities to trust in addition to the\n" +
+		"system certificate pool.",
+	"client_cert": "PEM encoded client certificate, or a path to one, used for mutual TLS.",
+	"client_key":  "PEM encoded private key, or a path to one, for the client certificate.",
+	"read_cache_ttl": "How long to cache successful API reads, as a duration string such as\n" +
+		"\"10s\". Identical reads in flight are also deduplicated. Disabled by default.",
+	"max_concurrent_requests": "The maximum number of API requests in flight at a time, shared by\n" +
+		"every resource and data source. Unlimited by default.",
+	"api_url": "The base URL of the API, such as \"https://tfe.example.com/api/v2/\". When set,\n" +
+		"service discovery is not used for the API.",
+	"service_overrides": "A map of service IDs, such as \"tfe.v2.2\", to the URL to use for them\n" +
+		"instead of discovering them.",
+	"skip_discovery": "Whether or not to skip service discovery and the check of the provider\n" +
+		"version constraints published by the host. The API is expected at /api/v2/\n" +
+		"on the host unless api_url is set.",
+	"read_only": "Whether or not to reject every API request other than GET, and every plan that\n" +
+		"would create, update or delete a resource. Defaults to false.",
+	"workload_identity_token_file": "Path to a file containing a workload identity token (JWT) used to\n" +
+		"authenticate when no token is set. The file is read again before the token expires.\n" +
+		"Can be set with the TFE_WORKLOAD_IDENTITY_TOKEN_FILE environment variable.",
+	"workload_identity_auth": "Whether or not to authenticate with the workload identity token of the\n" +
+		"HCP Terraform or Terraform Enterprise run, from TFC_WORKLOAD_IDENTITY_TOKEN, when no\n" +
+		"token is set. Can be set with the TFE_WORKLOAD_IDENTITY_AUTH environment variable.",
+	"workload_identity_exchange_url": "The URL where the workload identity token is exchanged for an access\n" +
+		"token. The workload identity token is used as the API token when unset. Can be set with\n" +
+		"the TFE_WORKLOAD_IDENTITY_EXCHANGE_URL environment variable.",
+	"default_tags": "Tags added to every resource that supports them, in addition to the tags\n" +
+		"set on the resource itself.",
+	"default_tags.tag_names": "The tag names added to every workspace.",
+	"deletion_protection": "Resources that must never be destroyed or replaced. Plans that would\n" +
+		"destroy or replace one of them fail.",
+	"deletion_protection.resource_types": "The resource types to protect, such as \"tfe_workspace\".",
+	"deletion_protection.name_patterns": "Glob patterns, such as \"prod-*\", matched against the name of\n" +
+		"the resources. When set, only the resources whose name matches one of them are protected.",
+	"organization_tokens": "A map of organization names to the token used for the resources in that\n" +
+		"organization. The token argument is used for every other organization, and is\n" +
+		"only required for them.",
+	"organization_token_files": "A map of organization names to the path of a file containing the token\n" +
+		"used for the resources in that organization. The file is read again when the\n" +
+		"token is rejected, so it can be rotated.",
 }
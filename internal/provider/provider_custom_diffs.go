@@ -5,7 +5,10 @@ package provider
 
 import (
 	"context"
+	"log"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -47,3 +50,106 @@ func modifyPlanForDefaultOrganizationChange(ctx context.Context, providerDefault
 		resp.RequiresReplace.Append(orgPath)
 	}
 }
+
+// customizeDiffCapabilities returns a CustomizeDiffFunc failing the plan when
+// the host doesn't support resourceType, for a resource about to be created,
+// or one of its attributes set in the configuration. See capabilityRegistry.
+func customizeDiffCapabilities(resourceType string) schema.CustomizeDiffFunc {
+	return customizeDiffCapabilitiesKey(resourceType, "organization")
+}
+
+// customizeDiffCapabilitiesKey is customizeDiffCapabilities for a resource
+// whose organization is set by the key attribute. The host is told with the
+// client for that organization, defaulting to the provider configuration.
+func customizeDiffCapabilitiesKey(resourceType, key string) schema.CustomizeDiffFunc {
+	return func(c context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		config := meta.(ConfiguredClient)
+		rawConfig := diff.GetRawConfig()
+
+		organization := config.Organization
+		if rawConfig.Type().HasAttribute(key) {
+			if v, ok := diff.GetOk(key); ok {
+				organization = v.(string)
+			}
+		}
+		capabilities := config.capabilityResolver(organization)
+		if capabilities == nil {
+			return nil
+		}
+
+		if diff.Id() == "" {
+			if err := checkCapability(capabilities, resourceType); err != nil && !capabilityRegistry[resourceType].warnOnly {
+				return err
+			}
+		}
+
+		for _, name := range registeredAttributes(resourceType) {
+			v := rawConfig.GetAttr(name)
+			// Unknown values, false and empty blocks are left alone, since
+			// they could be the same as not setting the attribute at all.
+			if v.IsNull() || !v.IsKnown() || (v.Type() == cty.Bool && v.False()) {
+				continue
+			}
+			if v.CanIterateElements() && v.LengthInt() == 0 {
+				continue
+			}
+
+			key := resourceType + "." + name
+			if err := checkCapability(capabilities, key); err != nil {
+				if capabilityRegistry[key].warnOnly {
+					log.Printf("[WARN] %s", err)
+					continue
+				}
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// modifyPlanForCapabilities adds an error to the plan when the host doesn't
+// support resourceType, for a resource about to be created, or one of its
+// attributes set in the configuration. It's called from ModifyPlan rather
+// than ValidateConfig, which can run before the provider is configured.
+func modifyPlanForCapabilities(ctx context.Context, capabilities capabilitiesResolver, resourceType string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the provider isn't configured or the resource is
+	// being destroyed.
+	if capabilities == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if err := checkCapability(capabilities, resourceType); err != nil {
+			if capabilityRegistry[resourceType].warnOnly {
+				resp.Diagnostics.AddWarning("Unsupported resource", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Unsupported resource", err.Error())
+				return
+			}
+		}
+	}
+
+	for _, name := range registeredAttributes(resourceType) {
+		var v attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &v)...)
+		if v == nil || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if b, ok := v.(types.Bool); ok && !b.ValueBool() {
+			continue
+		}
+		if l, ok := v.(types.List); ok && len(l.Elements()) == 0 {
+			continue
+		}
+
+		key := resourceType + "." + name
+		if err := checkCapability(capabilities, key); err != nil {
+			if capabilityRegistry[key].warnOnly {
+				resp.Diagnostics.AddAttributeWarning(path.Root(name), "Unsupported attribute", err.Error())
+			} else {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Unsupported attribute", err.Error())
+			}
+		}
+	}
+}
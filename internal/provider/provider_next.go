@@ -8,6 +8,8 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,14 +24,92 @@ type frameworkProvider struct{}
 
 // Compile-time interface check
 var _ provider.Provider = &frameworkProvider{}
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+var _ provider.ProviderWithFunctions = &frameworkProvider{}
 
 // FrameworkProviderConfig is a helper type for extracting the provider
 // configuration from the provider block.
 type FrameworkProviderConfig struct {
-	Hostname      types.String `tfsdk:"hostname"`
-	Token         types.String `tfsdk:"token"`
-	Organization  types.String `tfsdk:"organization"`
-	SSLSkipVerify types.Bool   `tfsdk:"ssl_skip_verify"`
+	Hostname                    types.String `tfsdk:"hostname"`
+	Token                       types.String `tfsdk:"token"`
+	TokenFile                   types.String `tfsdk:"token_file"`
+	Organization                types.String `tfsdk:"organization"`
+	SSLSkipVerify               types.Bool   `tfsdk:"ssl_skip_verify"`
+	MaxRetries                  types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin                types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax                types.String `tfsdk:"retry_wait_max"`
+	RetryServerErrors           types.Bool   `tfsdk:"retry_server_errors"`
+	CACertFile                  types.String `tfsdk:"ca_cert_file"`
+	CACertPEM                   types.String `tfsdk:"ca_cert_pem"`
+	ClientCert                  types.String `tfsdk:"client_cert"`
+	ClientKey                   types.String `tfsdk:"client_key"`
+	ReadCacheTTL                types.String `tfsdk:"read_cache_ttl"`
+	MaxConcurrentRequests       types.Int64  `tfsdk:"max_concurrent_requests"`
+	APIURL                      types.String `tfsdk:"api_url"`
+	ServiceOverrides            types.Map    `tfsdk:"service_overrides"`
+	SkipDiscovery               types.Bool   `tfsdk:"skip_discovery"`
+	ReadOnly                    types.Bool   `tfsdk:"read_only"`
+	WorkloadIdentityTokenFile   types.String `tfsdk:"workload_identity_token_file"`
+	WorkloadIdentityAuth        types.Bool   `tfsdk:"workload_identity_auth"`
+	WorkloadIdentityExchangeURL types.String `tfsdk:"workload_identity_exchange_url"`
+	OrganizationTokens          types.Map    `tfsdk:"organization_tokens"`
+	OrganizationTokenFiles      types.Map    `tfsdk:"organization_token_files"`
+	DefaultTags                 types.List   `tfsdk:"default_tags"`
+	DeletionProtection          types.List   `tfsdk:"deletion_protection"`
+}
+
+// clientOptions converts the provider configuration into the options used to
+// get a client, leaving null values unset so the client can fall back to env
+// vars and defaults.
+func (c FrameworkProviderConfig) clientOptions() *client.ClientOptions {
+	opts := &client.ClientOptions{
+		Hostname:      c.Hostname.ValueString(),
+		Token:         c.Token.ValueString(),
+		TokenFile:     c.TokenFile.ValueString(),
+		SSLSkipVerify: c.SSLSkipVerify.ValueBool(),
+		RetryWaitMin:  c.RetryWaitMin.ValueString(),
+		RetryWaitMax:  c.RetryWaitMax.ValueString(),
+		CACertFile:    c.CACertFile.ValueString(),
+		CACertPEM:     c.CACertPEM.ValueString(),
+		ClientCert:    c.ClientCert.ValueString(),
+		ClientKey:     c.ClientKey.ValueString(),
+		ReadCacheTTL:  c.ReadCacheTTL.ValueString(),
+		APIURL:        c.APIURL.ValueString(),
+
+		WorkloadIdentityTokenFile:   c.WorkloadIdentityTokenFile.ValueString(),
+		WorkloadIdentityExchangeURL: c.WorkloadIdentityExchangeURL.ValueString(),
+	}
+
+	if !c.MaxRetries.IsNull() {
+		maxRetries := int(c.MaxRetries.ValueInt64())
+		opts.MaxRetries = &maxRetries
+	}
+	if !c.RetryServerErrors.IsNull() {
+		opts.RetryServerErrors = c.RetryServerErrors.ValueBoolPointer()
+	}
+	if !c.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests := int(c.MaxConcurrentRequests.ValueInt64())
+		opts.MaxConcurrentRequests = &maxConcurrentRequests
+	}
+	if !c.ServiceOverrides.IsNull() {
+		opts.ServiceOverrides = make(map[string]string)
+		for id, u := range c.ServiceOverrides.Elements() {
+			if s, ok := u.(types.String); ok {
+				opts.ServiceOverrides[id] = s.ValueString()
+			}
+		}
+	}
+	if !c.SkipDiscovery.IsNull() {
+		opts.SkipDiscovery = c.SkipDiscovery.ValueBoolPointer()
+	}
+	if !c.ReadOnly.IsNull() {
+		opts.ReadOnly = c.ReadOnly.ValueBoolPointer()
+	}
+	if !c.WorkloadIdentityAuth.IsNull() {
+		opts.WorkloadIdentityAuth = c.WorkloadIdentityAuth.ValueBoolPointer()
+	}
+
+	return opts
 }
 
 // NewFrameworkProvider is a helper function for initializing the portion of
@@ -59,6 +139,10 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description: descriptions["token"],
 				// TODO: should be sensitive, but that's a breaking change.
 			},
+			"token_file": schema.StringAttribute{
+				Description: descriptions["token_file"],
+				Optional:    true,
+			},
 			"organization": schema.StringAttribute{
 				Description: descriptions["organization"],
 				Optional:    true,
@@ -67,6 +151,121 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description: descriptions["ssl_skip_verify"],
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: descriptions["max_retries"],
+				Optional:    true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Description: descriptions["retry_wait_min"],
+				Optional:    true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Description: descriptions["retry_wait_max"],
+				Optional:    true,
+			},
+			"retry_server_errors": schema.BoolAttribute{
+				Description: descriptions["retry_server_errors"],
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: descriptions["ca_cert_file"],
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: descriptions["ca_cert_pem"],
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: descriptions["client_cert"],
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: descriptions["client_key"],
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"read_cache_ttl": schema.StringAttribute{
+				Description: descriptions["read_cache_ttl"],
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: descriptions["max_concurrent_requests"],
+				Optional:    true,
+			},
+			"api_url": schema.StringAttribute{
+				Description: descriptions["api_url"],
+				Optional:    true,
+			},
+			"service_overrides": schema.MapAttribute{
+				Description: descriptions["service_overrides"],
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"skip_discovery": schema.BoolAttribute{
+				Description: descriptions["skip_discovery"],
+				Optional:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: descriptions["read_only"],
+				Optional:    true,
+			},
+			"workload_identity_token_file": schema.StringAttribute{
+				Description: descriptions["workload_identity_token_file"],
+				Optional:    true,
+			},
+			"workload_identity_auth": schema.BoolAttribute{
+				Description: descriptions["workload_identity_auth"],
+				Optional:    true,
+			},
+			"workload_identity_exchange_url": schema.StringAttribute{
+				Description: descriptions["workload_identity_exchange_url"],
+				Optional:    true,
+			},
+			"organization_tokens": schema.MapAttribute{
+				Description: descriptions["organization_tokens"],
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"organization_token_files": schema.MapAttribute{
+				Description: descriptions["organization_token_files"],
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		// Default tags only apply to classic resources, and deletion protection
+		// is checked by the server wrapping the muxed servers, but they must
+		// all share the same provider schema.
+		Blocks: map[string]schema.Block{
+			"deletion_protection": schema.ListNestedBlock{
+				Description: descriptions["deletion_protection"],
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.SetAttribute{
+							Description: descriptions["deletion_protection.resource_types"],
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"name_patterns": schema.ListAttribute{
+							Description: descriptions["deletion_protection.name_patterns"],
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"default_tags": schema.ListNestedBlock{
+				Description: descriptions["default_tags"],
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_names": schema.SetAttribute{
+							Description: descriptions["default_tags.tag_names"],
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -95,20 +294,33 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		data.Organization = types.StringValue(os.Getenv("TFE_ORGANIZATION"))
 	}
 
-	tfeClient, err := client.GetClient(data.Hostname.ValueString(), data.Token.ValueString(), data.SSLSkipVerify.ValueBool())
+	var organizationTokens, organizationTokenFiles map[string]string
+	if !data.OrganizationTokens.IsNull() {
+		res.Diagnostics.Append(data.OrganizationTokens.ElementsAs(ctx, &organizationTokens, false)...)
+	}
+	if !data.OrganizationTokenFiles.IsNull() {
+		res.Diagnostics.Append(data.OrganizationTokenFiles.ElementsAs(ctx, &organizationTokenFiles, false)...)
+	}
+	if res.Diagnostics.HasError() {
+		return
+	}
 
+	opts := data.clientOptions()
+	configuredClient, err := newConfiguredClient(opts, data.Organization.ValueString(), organizationTokens, organizationTokenFiles)
 	if err != nil {
 		res.Diagnostics.AddError("Failed to initialize HTTP client", err.Error())
 		return
 	}
 
-	configuredClient := ConfiguredClient{
-		Client:       tfeClient,
-		Organization: data.Organization.ValueString(),
+	// The muxed servers share the configuration, so only this one warns about
+	// the bypassed service discovery.
+	if warning := client.DiscoveryWarning(opts); warning != "" {
+		res.Diagnostics.AddWarning("Service discovery bypassed", warning)
 	}
 
 	res.DataSourceData = configuredClient
 	res.ResourceData = configuredClient
+	res.EphemeralResourceData = configuredClient
 }
 
 func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -126,6 +338,24 @@ func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource
 	}
 }
 
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAgentTokenEphemeralResource,
+		NewAuditTrailTokenEphemeralResource,
+		NewOrganizationTokenEphemeralResource,
+		NewTeamTokenEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIsIDFunction,
+		NewParseWorkspaceIDFunction,
+		NewSlugChecksumFunction,
+		NewVerifyRunTaskSignatureFunction,
+	}
+}
+
 func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAuditTrailTokenResource,
@@ -6,15 +6,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +33,9 @@ import (
 	"github.com/hashicorp/terraform-svchost/disco"
 )
 
+// ctx is used as default context.Context when making TFE calls in tests.
+var ctx = context.Background()
+
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 var testAccMuxedProviders map[string]func() (tfprotov5.ProviderServer, error)
@@ -46,7 +58,7 @@ func init() {
 				return nil, err
 			}
 
-			return mux.ProviderServer(), nil
+			return WithDeletionProtection(WithReadOnlyPlans(mux.ProviderServer))(), nil
 		},
 	}
 }
@@ -90,7 +102,7 @@ func getClientUsingEnv() (*tfe.Client, error) {
 	}
 	token := os.Getenv("TFE_TOKEN")
 
-	tfeClient, err := client.GetClient(hostname, token, defaultSSLSkipVerify)
+	tfeClient, err := client.GetClient(&client.ClientOptions{Hostname: hostname, Token: token, SSLSkipVerify: defaultSSLSkipVerify})
 	if err != nil {
 		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
@@ -107,6 +119,303 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProvider_resourcesUseContexts(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		if r.Create != nil || r.Read != nil || r.Update != nil || r.Delete != nil {
+			t.Errorf("%s: expected the context aware CRUD functions to be used, so operations can be canceled", name)
+		}
+		if r.CreateContext != nil || r.ReadContext != nil || r.UpdateContext != nil || r.DeleteContext != nil {
+			t.Errorf("%s: expected the CRUD functions to be set without the default deadline", name)
+		}
+		if r.Timeouts == nil || r.Timeouts.Create == nil || r.Timeouts.Delete == nil {
+			t.Errorf("%s: expected default timeouts", name)
+		}
+		if r.Timeouts != nil && (r.Timeouts.Update != nil) != (r.UpdateWithoutTimeout != nil) {
+			t.Errorf("%s: expected an update timeout only when the resource can be updated", name)
+		}
+	}
+	for name, d := range Provider().DataSourcesMap {
+		if d.Read != nil {
+			t.Errorf("%s: expected ReadContext to be used, so reads can be canceled", name)
+		}
+	}
+}
+
+func TestWithTimeouts(t *testing.T) {
+	deadlines := map[string]bool{}
+	record := func(key string) schema.CreateContextFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			_, deadlines[key] = ctx.Deadline()
+			return nil
+		}
+	}
+	r := &schema.Resource{
+		CreateContext: record(schema.TimeoutCreate),
+		ReadContext:   schema.ReadContextFunc(record(schema.TimeoutRead)),
+		DeleteContext: schema.DeleteContextFunc(record(schema.TimeoutDelete)),
+		Timeouts:      resourceTimeouts(false),
+	}
+	r.Timeouts.Delete = schema.DefaultTimeout(time.Hour)
+	withTimeouts(r)
+
+	ctx := context.Background()
+	d := r.Data(nil)
+	r.CreateWithoutTimeout(ctx, d, nil)
+	r.ReadWithoutTimeout(ctx, d, nil)
+	r.DeleteWithoutTimeout(ctx, d, nil)
+
+	if deadlines[schema.TimeoutCreate] || deadlines[schema.TimeoutRead] {
+		t.Errorf("expected no deadline without a timeout, got %v", deadlines)
+	}
+	if !deadlines[schema.TimeoutDelete] {
+		t.Errorf("expected a deadline with a timeout, got %v", deadlines)
+	}
+	if r.UpdateWithoutTimeout != nil {
+		t.Errorf("expected no update function")
+	}
+}
+
+func TestProvider_organizationClients(t *testing.T) {
+	defaultClient, orgClient := &tfe.Client{}, &tfe.Client{}
+	meta := ConfiguredClient{
+		Client:              defaultClient,
+		Organization:        "default-org",
+		organizationClients: map[string]*tfe.Client{"other-org": orgClient},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"organization": {Type: schema.TypeString, Optional: true},
+		},
+	}
+
+	var got *tfe.Client
+	read := withOrganizationClient(schema.ReadContextFunc(func(_ context.Context, _ *schema.ResourceData, meta any) diag.Diagnostics {
+		got = meta.(ConfiguredClient).Client
+		return nil
+	}))
+
+	cases := map[string]struct {
+		organization string
+		expected     *tfe.Client
+	}{
+		"organization with a token": {"other-org", orgClient},
+		"organization without one":  {"third-org", defaultClient},
+		"provider organization":     {"", defaultClient},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"organization": tc.organization})
+			read(ctx, d, meta)
+			if got != tc.expected {
+				t.Fatalf("expected the client for %q to be used", tc.organization)
+			}
+		})
+	}
+
+	// Without a default token, only the organizations with a token can be used.
+	meta.defaultTokenMissing = true
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"organization": "other-org"})
+	if diags := read(ctx, d, meta); diags.HasError() || got != orgClient {
+		t.Fatalf("expected the client for other-org to be used, got %v", diags)
+	}
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"organization": "third-org"})
+	if diags := read(ctx, d, meta); !diags.HasError() {
+		t.Fatal("expected an error for an organization without a token")
+	}
+}
+
+func TestProvider_parentOrganizationClients(t *testing.T) {
+	// Only the token of other-org can read its workspace and team.
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.Header.Get("Authorization") != "Bearer org-token" {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errors": [{"status": "404", "title": "not found"}]}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-123":
+			io.WriteString(w, `{"data": {"id": "ws-123", "type": "workspaces", "attributes": {"name": "example"}, "relationships": {"organization": {"data": {"id": "other-org", "type": "organizations"}}}}}`)
+		case "/api/v2/teams/team-123":
+			io.WriteString(w, `{"data": {"id": "team-123", "type": "teams", "attributes": {"name": "example"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errors": [{"status": "404", "title": "not found"}]}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	newClient := func(token string) *tfe.Client {
+		c, err := tfe.NewClient(&tfe.Config{Address: srv.URL, Token: token})
+		if err != nil {
+			t.Fatalf("Unexpected error creating the client: %v", err)
+		}
+		return c
+	}
+
+	defaultClient, firstClient, orgClient := newClient("default-token"), newClient("first-token"), newClient("org-token")
+	meta := ConfiguredClient{
+		Client:              defaultClient,
+		Organization:        "default-org",
+		organizationClients: map[string]*tfe.Client{"first-org": firstClient, "other-org": orgClient},
+		parentOrganizations: &sync.Map{},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {Type: schema.TypeString, Optional: true},
+			"team_id":      {Type: schema.TypeString, Optional: true},
+		},
+	}
+
+	var got *tfe.Client
+	r.ReadContext = func(_ context.Context, _ *schema.ResourceData, meta any) diag.Diagnostics {
+		got = meta.(ConfiguredClient).Client
+		return nil
+	}
+	withParentOrganizationClients(r)
+
+	cases := map[string]struct {
+		attrs    map[string]interface{}
+		expected *tfe.Client
+	}{
+		"workspace in an organization with a token": {map[string]interface{}{"workspace_id": "ws-123"}, orgClient},
+		"team in an organization with a token":      {map[string]interface{}{"team_id": "team-123"}, orgClient},
+		"parent that can't be read":                 {map[string]interface{}{"workspace_id": "ws-456"}, defaultClient},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, tc.attrs)
+			r.ReadContext(ctx, d, meta)
+			if got != tc.expected {
+				t.Fatalf("expected the client for the organization of %v to be used", tc.attrs)
+			}
+		})
+	}
+
+	// The organization of a parent is only looked up once.
+	before := atomic.LoadInt32(&requests)
+	r.ReadContext(ctx, schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"workspace_id": "ws-123"}), meta)
+	if got != orgClient || atomic.LoadInt32(&requests) != before {
+		t.Fatal("expected the organization of the workspace to be remembered")
+	}
+
+	// Without a default token, a parent that can't be read is reported with an
+	// organization client.
+	meta.defaultTokenMissing = true
+	r.ReadContext(ctx, schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"workspace_id": "ws-456"}), meta)
+	if got != firstClient {
+		t.Fatal("expected an organization client to be used without a default token")
+	}
+}
+
+func TestProvider_frameworkOrganizationClients(t *testing.T) {
+	// Only the token of the organization can read the run task.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.Header.Get("Authorization") != "Bearer org-token" || r.URL.Path != "/api/v2/tasks/task-123" {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errors": [{"status": "404", "title": "not found"}]}`)
+			return
+		}
+		io.WriteString(w, `{"data": {"id": "task-123", "type": "tasks", "attributes": {"name": "example", "url": "https://example.com", "category": "task", "enabled": true}, "relationships": {"organization": {"data": {"id": "other-org", "type": "organizations"}}}}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	newClient := func(token string) *tfe.Client {
+		c, err := tfe.NewClient(&tfe.Config{Address: srv.URL, Token: token})
+		if err != nil {
+			t.Fatalf("Unexpected error creating the client: %v", err)
+		}
+		return c
+	}
+
+	r := &resourceOrgRunTask{config: ConfiguredClient{
+		Client:              newClient("default-token"),
+		Organization:        "default-org",
+		organizationClients: map[string]*tfe.Client{"other-org": newClient("org-token")},
+	}}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, &modelTFEOrganizationRunTaskV0{
+		ID:           types.StringValue("task-123"),
+		Organization: types.StringValue("other-org"),
+		Name:         types.StringValue("example"),
+		URL:          types.StringValue("https://example.com"),
+		Category:     types.StringValue("task"),
+		Enabled:      types.BoolValue(true),
+		Description:  types.StringNull(),
+		HMACKey:      types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error setting the state: %v", diags)
+	}
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error reading the run task: %v", resp.Diagnostics)
+	}
+	if resp.State.Raw.IsNull() {
+		t.Fatal("Expected the run task to be read with the token of its organization")
+	}
+}
+
+func TestProvider_capabilitiesWithOrganizationTokens(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer org-token" {
+			w.Header().Set("TFP-AppName", "HCP Terraform")
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	orgClient, err := tfe.NewClient(&tfe.Config{Address: srv.URL, Token: "org-token"})
+	if err != nil {
+		t.Fatalf("Unexpected error creating the client: %v", err)
+	}
+	// Without a default token, the provider's client can't tell the host.
+	defaultClient, err := client.GetClientWithoutToken(&client.ClientOptions{Hostname: "app.terraform.io"})
+	if err != nil {
+		t.Fatalf("Unexpected error creating the client: %v", err)
+	}
+	config := ConfiguredClient{
+		Client:              defaultClient,
+		Organization:        "org",
+		organizationClients: map[string]*tfe.Client{"org": orgClient},
+		defaultTokenMissing: true,
+	}
+
+	if err := checkCapability(config.capabilityResolver("org"), "tfe_stack"); err != nil {
+		t.Errorf("expected the capabilities to be told by the organization client, got %v", err)
+	}
+	if resolver := config.capabilityResolver("other-org"); resolver != nil {
+		t.Errorf("expected no capabilities for an organization without a token, got %v", resolver)
+	}
+
+	r := &resourceTFEStack{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: config}, &resource.ConfigureResponse{})
+	if err := checkCapability(r.capabilities, "tfe_stack"); err != nil {
+		t.Errorf("expected tfe_stack to be supported with the default organization's token, got %v", err)
+	}
+}
+
 func TestProvider_versionConstraints(t *testing.T) {
 	cases := map[string]struct {
 		constraints *disco.Constraints
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
+)
+
+// readOnlyServer fails plans that would create, update or delete a resource
+// when read_only is enabled. The client rejects the API requests anyway, but
+// failing the plan guarantees that a successful plan is free of side effects.
+// It wraps the muxed server, as it applies to the resources of every server.
+type readOnlyServer struct {
+	tfprotov5.ProviderServer
+
+	readOnly atomic.Bool
+
+	typesOnce     sync.Once
+	resourceTypes map[string]tftypes.Type
+}
+
+// WithReadOnlyPlans wraps a provider server so that plans with changes fail
+// when read_only is enabled.
+func WithReadOnlyPlans(server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &readOnlyServer{ProviderServer: server()}
+	}
+}
+
+func (s *readOnlyServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	// Invalid configurations are reported by the servers configuring the
+	// client, so they are ignored here.
+	meta, err := retrieveProviderMeta(req)
+	if err != nil {
+		return resp, nil
+	}
+	readOnly, err := client.ReadOnly(meta.clientOptions())
+	if err != nil {
+		return resp, nil
+	}
+	s.readOnly.Store(readOnly)
+
+	return resp, nil
+}
+
+func (s *readOnlyServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil || !s.readOnly.Load() {
+		return resp, err
+	}
+
+	action, err := s.plannedAction(ctx, req, resp)
+	if err != nil {
+		log.Printf("[WARN] Failed to determine the planned change of %s: %v", req.TypeName, err)
+		return resp, nil
+	}
+	if action == "" {
+		return resp, nil
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Changes are not allowed in read-only mode",
+		Detail: fmt.Sprintf("This plan would %s this %s, but the provider is configured with read_only, which blocks every change. "+
+			"Disable read_only, and unset TFE_READ_ONLY, to make changes.", action, req.TypeName),
+	})
+
+	return resp, nil
+}
+
+// plannedAction returns "create", "update" or "delete" for a plan that
+// changes the resource, or "" when it doesn't.
+func (s *readOnlyServer) plannedAction(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest, resp *tfprotov5.PlanResourceChangeResponse) (string, error) {
+	resourceType, err := s.resourceType(ctx, req.TypeName)
+	if err != nil {
+		return "", err
+	}
+
+	prior, err := dynamicValue(req.PriorState, resourceType)
+	if err != nil {
+		return "", err
+	}
+	planned, err := dynamicValue(resp.PlannedState, resourceType)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case prior.IsNull() && planned.IsNull():
+		return "", nil
+	case prior.IsNull():
+		return "create", nil
+	case planned.IsNull():
+		return "delete", nil
+	case !prior.Equal(planned):
+		return "update", nil
+	default:
+		return "", nil
+	}
+}
+
+// resourceType returns the type of the state of a resource, from the schemas
+// of the wrapped server.
+func (s *readOnlyServer) resourceType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	s.typesOnce.Do(func() {
+		s.resourceTypes = map[string]tftypes.Type{}
+
+		resp, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil || resp == nil {
+			return
+		}
+		for name, schema := range resp.ResourceSchemas {
+			s.resourceTypes[name] = schema.ValueType()
+		}
+	})
+
+	resourceType, ok := s.resourceTypes[typeName]
+	if !ok {
+		return nil, errUnsupportedResource(typeName)
+	}
+	return resourceType, nil
+}
+
+// dynamicValue decodes v, which is null when it isn't set.
+func dynamicValue(v *tfprotov5.DynamicValue, typ tftypes.Type) (tftypes.Value, error) {
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	return v.Unmarshal(typ)
+}
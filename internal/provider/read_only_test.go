@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testPlanServer plans the proposed new state of a single resource type, and
+// accepts any provider configuration.
+type testPlanServer struct {
+	tfprotov5.ProviderServer
+}
+
+var testPlanSchema = &tfprotov5.Schema{
+	Block: &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{Name: "name", Type: tftypes.String, Required: true},
+		},
+	},
+}
+
+func (s testPlanServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{"tfe_test": testPlanSchema},
+	}, nil
+}
+
+func (s testPlanServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	return &tfprotov5.ConfigureProviderResponse{}, nil
+}
+
+func (s testPlanServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return &tfprotov5.PlanResourceChangeResponse{PlannedState: req.ProposedNewState}, nil
+}
+
+func TestReadOnlyServer_planResourceChange(t *testing.T) {
+	testState := func(name *string) *tfprotov5.DynamicValue {
+		typ := testPlanSchema.ValueType()
+		val := tftypes.NewValue(typ, nil)
+		if name != nil {
+			val = tftypes.NewValue(typ, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, *name)})
+		}
+		v, err := tfprotov5.NewDynamicValue(typ, val)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &v
+	}
+	before, after := "before", "after"
+
+	cases := map[string]struct {
+		readOnly  bool
+		prior     *string
+		proposed  *string
+		expectErr bool
+	}{
+		"create":             {readOnly: true, proposed: &after, expectErr: true},
+		"update":             {readOnly: true, prior: &before, proposed: &after, expectErr: true},
+		"delete":             {readOnly: true, prior: &before, expectErr: true},
+		"no changes":         {readOnly: true, prior: &before, proposed: &before},
+		"read_only disabled": {prior: &before, proposed: &after},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := WithReadOnlyPlans(func() tfprotov5.ProviderServer { return testPlanServer{} })()
+
+			_, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+				Config: testProviderConfig(t, map[string]tftypes.Value{
+					"read_only": tftypes.NewValue(tftypes.Bool, tc.readOnly),
+				}),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "tfe_test",
+				PriorState:       testState(tc.prior),
+				ProposedNewState: testState(tc.proposed),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := len(resp.Diagnostics) > 0; got != tc.expectErr {
+				t.Fatalf("expected an error diagnostic: %t, got %v", tc.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
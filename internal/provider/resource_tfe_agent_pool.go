@@ -15,15 +15,17 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEAgentPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEAgentPoolCreate,
-		Read:   resourceTFEAgentPoolRead,
-		Update: resourceTFEAgentPoolUpdate,
-		Delete: resourceTFEAgentPoolDelete,
+		CreateContext: resourceTFEAgentPoolCreate,
+		ReadContext:   resourceTFEAgentPoolRead,
+		UpdateContext: resourceTFEAgentPoolUpdate,
+		DeleteContext: resourceTFEAgentPoolDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEAgentPoolImporter,
 		},
@@ -52,14 +54,14 @@ func resourceTFEAgentPool() *schema.Resource {
 	}
 }
 
-func resourceTFEAgentPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -71,16 +73,16 @@ func resourceTFEAgentPoolCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create new agent pool for organization: %s", organization)
 	agentPool, err := config.Client.AgentPools.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating agent pool %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating agent pool %s for organization %s: %v", name, organization, err)
 	}
 
 	d.SetId(agentPool.ID)
 
-	return resourceTFEAgentPoolRead(d, meta)
+	return resourceTFEAgentPoolRead(ctx, d, meta)
 }
 
-func resourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of agent pool: %s", d.Id())
@@ -91,7 +93,7 @@ func resourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of agent pool %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of agent pool %s: %v", d.Id(), err)
 	}
 
 	// Update the config.
@@ -102,7 +104,7 @@ func resourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEAgentPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Create a new options struct.
@@ -114,13 +116,13 @@ func resourceTFEAgentPoolUpdate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Update agent pool: %s", d.Id())
 	_, err := config.Client.AgentPools.Update(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error updating agent pool %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating agent pool %s: %v", d.Id(), err)
 	}
 
-	return resourceTFEAgentPoolRead(d, meta)
+	return resourceTFEAgentPoolRead(ctx, d, meta)
 }
 
-func resourceTFEAgentPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete agent pool: %s", d.Id())
@@ -129,7 +131,7 @@ func resourceTFEAgentPoolDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting agent pool %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting agent pool %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -147,7 +149,7 @@ func resourceTFEAgentPoolImporter(ctx context.Context, d *schema.ResourceData, m
 	} else if len(s) == 2 {
 		org := s[0]
 		poolName := s[1]
-		pool, err := fetchAgentPool(org, poolName, config.Client)
+		pool, err := fetchAgentPool(ctx, org, poolName, config.Client)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving agent pool with name %s from organization %s %w", poolName, org, err)
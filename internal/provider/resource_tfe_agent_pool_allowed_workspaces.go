@@ -9,22 +9,24 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"log"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEAgentPoolAllowedWorkspaces() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEAgentPoolAllowedWorkspacesCreate,
-		Read:   resourceTFEAgentPoolAllowedWorkspacesRead,
-		Update: resourceTFEAgentPoolAllowedWorkspacesUpdate,
-		Delete: resourceTFEAgentPoolAllowedWorkspacesDelete,
+		CreateContext: resourceTFEAgentPoolAllowedWorkspacesCreate,
+		ReadContext:   resourceTFEAgentPoolAllowedWorkspacesRead,
+		UpdateContext: resourceTFEAgentPoolAllowedWorkspacesUpdate,
+		DeleteContext: resourceTFEAgentPoolAllowedWorkspacesDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByIDOrName("agent pool", fetchAgentPoolID),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,7 +45,7 @@ func resourceTFEAgentPoolAllowedWorkspaces() *schema.Resource {
 	}
 }
 
-func resourceTFEAgentPoolAllowedWorkspacesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolAllowedWorkspacesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	apID := d.Get("agent_pool_id").(string)
@@ -63,7 +65,7 @@ func resourceTFEAgentPoolAllowedWorkspacesCreate(d *schema.ResourceData, meta in
 	log.Printf("[DEBUG] Update agent pool: %s", apID)
 	_, err := config.Client.AgentPools.UpdateAllowedWorkspaces(ctx, apID, options)
 	if err != nil {
-		return fmt.Errorf("Error updating agent pool %s: %w", apID, err)
+		return diag.Errorf("Error updating agent pool %s: %v", apID, err)
 	}
 
 	d.SetId(apID)
@@ -71,7 +73,7 @@ func resourceTFEAgentPoolAllowedWorkspacesCreate(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTFEAgentPoolAllowedWorkspacesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolAllowedWorkspacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	agentPool, err := config.Client.AgentPools.Read(ctx, d.Id())
@@ -81,7 +83,7 @@ func resourceTFEAgentPoolAllowedWorkspacesRead(d *schema.ResourceData, meta inte
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of agent pool %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of agent pool %s: %v", d.Id(), err)
 	}
 
 	var allowedWorkspaceIDs []string
@@ -94,7 +96,7 @@ func resourceTFEAgentPoolAllowedWorkspacesRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTFEAgentPoolAllowedWorkspacesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolAllowedWorkspacesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	apID := d.Get("agent_pool_id").(string)
@@ -116,7 +118,7 @@ func resourceTFEAgentPoolAllowedWorkspacesUpdate(d *schema.ResourceData, meta in
 	log.Printf("[DEBUG] Update agent pool: %s", apID)
 	_, err := config.Client.AgentPools.UpdateAllowedWorkspaces(ctx, apID, options)
 	if err != nil {
-		return fmt.Errorf("Error updating agent pool %s: %w", apID, err)
+		return diag.Errorf("Error updating agent pool %s: %v", apID, err)
 	}
 
 	d.SetId(apID)
@@ -124,7 +126,7 @@ func resourceTFEAgentPoolAllowedWorkspacesUpdate(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTFEAgentPoolAllowedWorkspacesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolAllowedWorkspacesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	apID := d.Get("agent_pool_id").(string)
@@ -137,7 +139,7 @@ func resourceTFEAgentPoolAllowedWorkspacesDelete(d *schema.ResourceData, meta in
 	log.Printf("[DEBUG] Update agent pool: %s", apID)
 	_, err := config.Client.AgentPools.UpdateAllowedWorkspaces(ctx, apID, options)
 	if err != nil {
-		return fmt.Errorf("Error updating agent pool %s: %w", apID, err)
+		return diag.Errorf("Error updating agent pool %s: %v", apID, err)
 	}
 
 	return nil
@@ -9,18 +9,20 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEAgentToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEAgentTokenCreate,
-		Read:   resourceTFEAgentTokenRead,
-		Delete: resourceTFEAgentTokenDelete,
+		CreateContext: resourceTFEAgentTokenCreate,
+		ReadContext:   resourceTFEAgentTokenRead,
+		DeleteContext: resourceTFEAgentTokenDelete,
+		Timeouts:      resourceTimeouts(false),
 
 		Schema: map[string]*schema.Schema{
 			"agent_pool_id": {
@@ -42,7 +44,7 @@ func resourceTFEAgentToken() *schema.Resource {
 	}
 }
 
-func resourceTFEAgentTokenCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the agent pool ID
@@ -59,7 +61,7 @@ func resourceTFEAgentTokenCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create new agent token for agent pool ID: %s", agentPoolID)
 	agentToken, err := config.Client.AgentTokens.Create(ctx, agentPoolID, options)
 	if err != nil {
-		return fmt.Errorf("Error creating agent token for agent pool ID %s: %w", agentPoolID, err)
+		return diag.Errorf("Error creating agent token for agent pool ID %s: %v", agentPoolID, err)
 	}
 
 	d.SetId(agentToken.ID)
@@ -68,10 +70,10 @@ func resourceTFEAgentTokenCreate(d *schema.ResourceData, meta interface{}) error
 	// only be returned once during the creation of the token.
 	d.Set("token", agentToken.Token)
 
-	return resourceTFEAgentTokenRead(d, meta)
+	return resourceTFEAgentTokenRead(ctx, d, meta)
 }
 
-func resourceTFEAgentTokenRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of agent token: %s", d.Id())
@@ -82,7 +84,7 @@ func resourceTFEAgentTokenRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of agent token %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of agent token %s: %v", d.Id(), err)
 	}
 
 	// Update the config
@@ -91,7 +93,7 @@ func resourceTFEAgentTokenRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEAgentTokenDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete agent token: %s", d.Id())
@@ -100,7 +102,7 @@ func resourceTFEAgentTokenDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting agent token %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting agent token %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -267,6 +267,11 @@ func (r *resourceAuditTrailToken) Delete(ctx context.Context, req resource.Delet
 func (r *resourceAuditTrailToken) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	organization := req.ID
 
+	resp.Diagnostics.Append(r.config.useImportOrganization(organization)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tokenType := tfe.AuditTrailToken
 
 	tflog.Debug(ctx, "Reading audit trail token")
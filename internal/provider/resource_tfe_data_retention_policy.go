@@ -30,6 +30,7 @@ import (
 var _ resource.Resource = &resourceTFEDataRetentionPolicy{}
 var _ resource.ResourceWithConfigure = &resourceTFEDataRetentionPolicy{}
 var _ resource.ResourceWithImportState = &resourceTFEDataRetentionPolicy{}
+var _ resource.ResourceWithModifyPlan = &resourceTFEDataRetentionPolicy{}
 
 func NewDataRetentionPolicyResource() resource.Resource {
 	return &resourceTFEDataRetentionPolicy{}
@@ -129,6 +130,18 @@ func (r *resourceTFEDataRetentionPolicy) Configure(ctx context.Context, req reso
 	r.config = client
 }
 
+func (r *resourceTFEDataRetentionPolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	organization := r.config.Organization
+	if !req.Plan.Raw.IsNull() {
+		var planned types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization"), &planned)...)
+		if !planned.IsNull() && !planned.IsUnknown() {
+			organization = planned.ValueString()
+		}
+	}
+	modifyPlanForCapabilities(ctx, r.config.capabilityResolver(organization), "tfe_data_retention_policy", req, resp)
+}
+
 func (r *resourceTFEDataRetentionPolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan modelTFEDataRetentionPolicy
 
@@ -139,6 +152,11 @@ func (r *resourceTFEDataRetentionPolicy) Create(ctx context.Context, req resourc
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	r.ensureOrganizationIsSet(ctx, &plan, req.Plan, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
@@ -248,6 +266,11 @@ func (r *resourceTFEDataRetentionPolicy) Read(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var policy *tfe.DataRetentionPolicyChoice
 	var err error
 	if state.WorkspaceID.IsNull() {
@@ -293,6 +316,11 @@ func (r *resourceTFEDataRetentionPolicy) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if state.WorkspaceID.IsNull() {
 		tflog.Debug(ctx, fmt.Sprintf("Deleting data retention policy for organization: %s", state.Organization))
 		err := r.config.Client.Organizations.DeleteDataRetentionPolicy(ctx, state.Organization.ValueString())
@@ -320,8 +348,13 @@ func (r *resourceTFEDataRetentionPolicy) ImportState(ctx context.Context, req re
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useImportOrganization(s[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if len(s) == 2 {
-		workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], r.config.Client)
+		workspaceID, err := fetchWorkspaceExternalID(ctx, s[0]+"/"+s[1], r.config.Client)
 		if err != nil {
 			resp.Diagnostics.AddError("Error importing data retention policy", fmt.Sprintf(
 				"error retrieving workspace with name %s from organization %s: %s", s[1], s[0], err.Error(),
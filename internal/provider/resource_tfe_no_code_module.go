@@ -27,6 +27,7 @@ func resourceTFENoCodeModule() *schema.Resource {
 		ReadContext:   resourceTFENoCodeModuleRead,
 		UpdateContext: resourceTFENoCodeModuleUpdate,
 		DeleteContext: resourceTFENoCodeModuleDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
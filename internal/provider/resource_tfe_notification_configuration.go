@@ -9,22 +9,26 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFENotificationConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFENotificationConfigurationCreate,
-		Read:   resourceTFENotificationConfigurationRead,
-		Update: resourceTFENotificationConfigurationUpdate,
-		Delete: resourceTFENotificationConfigurationDelete,
+		CreateContext: resourceTFENotificationConfigurationCreate,
+		ReadContext:   resourceTFENotificationConfigurationRead,
+		UpdateContext: resourceTFENotificationConfigurationUpdate,
+		DeleteContext: resourceTFENotificationConfigurationDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTFENotificationConfigurationImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -115,7 +119,7 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 	}
 }
 
-func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFENotificationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get workspace
@@ -134,7 +138,7 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 		// 1. url and token cannot be set
 		err := validateSchemaAttributesForDestinationTypeEmail(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if destinationType == tfe.NotificationDestinationTypeGeneric {
 		// When destination_type is 'generic':
@@ -142,7 +146,7 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 		// 2. url must be set
 		err := validateSchemaAttributesForDestinationTypeGeneric(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if destinationType == tfe.NotificationDestinationTypeSlack {
 		// When destination_type is 'slack':
@@ -150,7 +154,7 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 		// 2. url must be set
 		err := validateSchemaAttributesForDestinationTypeSlack(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if destinationType == tfe.NotificationDestinationTypeMicrosoftTeams {
 		// When destination_type is 'microsoft-teams':
@@ -158,7 +162,7 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 		// 2. url must be set
 		err := validateSchemaAttributesForDestinationTypeMicrosoftTeams(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -193,15 +197,15 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 	log.Printf("[DEBUG] Create notification configuration: %s", name)
 	notificationConfiguration, err := config.Client.NotificationConfigurations.Create(ctx, workspaceID, options)
 	if err != nil {
-		return fmt.Errorf("Error creating notification configuration %s: %w", name, err)
+		return diag.Errorf("Error creating notification configuration %s: %v", name, err)
 	}
 
 	d.SetId(notificationConfiguration.ID)
 
-	return resourceTFENotificationConfigurationRead(d, meta)
+	return resourceTFENotificationConfigurationRead(ctx, d, meta)
 }
 
-func resourceTFENotificationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFENotificationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read notification configuration: %s", d.Id())
@@ -212,7 +216,7 @@ func resourceTFENotificationConfigurationRead(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading notification configuration %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading notification configuration %s: %v", d.Id(), err)
 	}
 
 	// Update config
@@ -247,7 +251,7 @@ func resourceTFENotificationConfigurationRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFENotificationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get attributes
@@ -263,7 +267,7 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 		// 1. url and token cannot be set
 		err := validateSchemaAttributesForDestinationTypeEmail(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if destinationType == tfe.NotificationDestinationTypeGeneric {
 		// When destination_type is 'generic':
@@ -271,7 +275,7 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 		// 2. url must be set
 		err := validateSchemaAttributesForDestinationTypeGeneric(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if destinationType == tfe.NotificationDestinationTypeSlack {
 		// When destination_type is 'slack':
@@ -279,7 +283,7 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 		// 2. url must be set
 		err := validateSchemaAttributesForDestinationTypeSlack(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if destinationType == tfe.NotificationDestinationTypeMicrosoftTeams {
 		// When destination_type is 'microsoft-teams':
@@ -287,7 +291,7 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 		// 2. url must be set
 		err := validateSchemaAttributesForDestinationTypeMicrosoftTeams(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -321,13 +325,13 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 	log.Printf("[DEBUG] Update notification configuration: %s", d.Id())
 	_, err := config.Client.NotificationConfigurations.Update(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error updating notification configuration %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating notification configuration %s: %v", d.Id(), err)
 	}
 
-	return resourceTFENotificationConfigurationRead(d, meta)
+	return resourceTFENotificationConfigurationRead(ctx, d, meta)
 }
 
-func resourceTFENotificationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFENotificationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete notification configuration: %s", d.Id())
@@ -336,7 +340,7 @@ func resourceTFENotificationConfigurationDelete(d *schema.ResourceData, meta int
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting notification configuration %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting notification configuration %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -424,3 +428,40 @@ func validateSchemaAttributesForDestinationTypeMicrosoftTeams(d *schema.Resource
 
 	return nil
 }
+
+func resourceTFENotificationConfigurationImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(ConfiguredClient)
+
+	// Import formats:
+	//  - <NOTIFICATION CONFIGURATION ID>
+	//  - <ORGANIZATION NAME>/<WORKSPACE NAME>/<NOTIFICATION CONFIGURATION NAME>
+	s := strings.SplitN(d.Id(), "/", 3)
+	switch len(s) {
+	case 1:
+		return []*schema.ResourceData{d}, nil
+	case 3:
+	default:
+		return nil, fmt.Errorf(
+			"invalid notification configuration import format: %s (expected <NOTIFICATION CONFIGURATION ID> or <ORGANIZATION>/<WORKSPACE NAME>/<NOTIFICATION CONFIGURATION NAME>)",
+			d.Id(),
+		)
+	}
+
+	org, workspaceName, name := s[0], s[1], s[2]
+	if err := config.useOrganization(org); err != nil {
+		return nil, err
+	}
+	workspaceID, err := fetchWorkspaceExternalID(ctx, org+"/"+workspaceName, config.Client)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving workspace %s from organization %s: %w", workspaceName, org, err)
+	}
+	notificationConfiguration, err := fetchNotificationConfigurationByName(ctx, config.Client, workspaceID, name)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving notification configuration with name %s from workspace %s: %w", name, workspaceName, err)
+	}
+	d.SetId(notificationConfiguration.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -9,20 +9,22 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEOAuthClient() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOAuthClientCreate,
-		Read:   resourceTFEOAuthClientRead,
-		Delete: resourceTFEOAuthClientDelete,
-		Update: resourceTFEOAuthClientUpdate,
+		CreateContext: resourceTFEOAuthClientCreate,
+		ReadContext:   resourceTFEOAuthClientRead,
+		DeleteContext: resourceTFEOAuthClientDelete,
+		UpdateContext: resourceTFEOAuthClientUpdate,
+		Timeouts:      resourceTimeouts(true),
 
 		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
 
@@ -126,13 +128,13 @@ func resourceTFEOAuthClient() *schema.Resource {
 	}
 }
 
-func resourceTFEOAuthClientCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOAuthClientCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization and provider.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	name := d.Get("name").(string)
 	privateKey := d.Get("private_key").(string)
@@ -142,7 +144,7 @@ func resourceTFEOAuthClientCreate(d *schema.ResourceData, meta interface{}) erro
 	serviceProvider := tfe.ServiceProviderType(d.Get("service_provider").(string))
 
 	if serviceProvider == tfe.ServiceProviderAzureDevOpsServer && privateKey == "" {
-		return fmt.Errorf("private_key is required for service_provider %s", serviceProvider)
+		return diag.Errorf("private_key is required for service_provider %s", serviceProvider)
 	}
 
 	// Create a new options struct.
@@ -175,16 +177,16 @@ func resourceTFEOAuthClientCreate(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Create an OAuth client for organization: %s", organization)
 	oc, err := config.Client.OAuthClients.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating OAuth client for organization %s: %w", organization, err)
+		return diag.Errorf(
+			"Error creating OAuth client for organization %s: %v", organization, err)
 	}
 
 	d.SetId(oc.ID)
 
-	return resourceTFEOAuthClientRead(d, meta)
+	return resourceTFEOAuthClientRead(ctx, d, meta)
 }
 
-func resourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOAuthClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of OAuth client: %s", d.Id())
@@ -195,7 +197,7 @@ func resourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Update the config.
@@ -211,13 +213,13 @@ func resourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error
 	case 1:
 		d.Set("oauth_token_id", oc.OAuthTokens[0].ID)
 	default:
-		return fmt.Errorf("unexpected number of OAuth tokens: %d", len(oc.OAuthTokens))
+		return diag.Errorf("unexpected number of OAuth tokens: %d", len(oc.OAuthTokens))
 	}
 
 	return nil
 }
 
-func resourceTFEOAuthClientDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOAuthClientDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete OAuth client: %s", d.Id())
@@ -226,13 +228,13 @@ func resourceTFEOAuthClientDelete(d *schema.ResourceData, meta interface{}) erro
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting OAuth client %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting OAuth client %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceTFEOAuthClientUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOAuthClientUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Create a new options struct.
@@ -243,8 +245,8 @@ func resourceTFEOAuthClientUpdate(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Update OAuth client %s", d.Id())
 	_, err := config.Client.OAuthClients.Update(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error updating OAuth client %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating OAuth client %s: %v", d.Id(), err)
 	}
 
-	return resourceTFEOAuthClientRead(d, meta)
+	return resourceTFEOAuthClientRead(ctx, d, meta)
 }
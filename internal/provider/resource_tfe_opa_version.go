@@ -11,19 +11,23 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEOPAVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOPAVersionCreate,
-		Read:   resourceTFEOPAVersionRead,
-		Update: resourceTFEOPAVersionUpdate,
-		Delete: resourceTFEOPAVersionDelete,
+		CreateContext: resourceTFEOPAVersionCreate,
+		ReadContext:   resourceTFEOPAVersionRead,
+		UpdateContext: resourceTFEOPAVersionUpdate,
+		DeleteContext: resourceTFEOPAVersionDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOPAVersionImporter,
 		},
 
+		CustomizeDiff: customizeDiffCapabilities("tfe_opa_version"),
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeString,
@@ -66,7 +70,7 @@ func resourceTFEOPAVersion() *schema.Resource {
 	}
 }
 
-func resourceTFEOPAVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOPAVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	opts := tfe.AdminOPAVersionCreateOptions{
@@ -83,15 +87,15 @@ func resourceTFEOPAVersionCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create new OPA version: %s", opts.Version)
 	v, err := config.Client.Admin.OPAVersions.Create(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("error creating the new OPA version %s: %w", opts.Version, err)
+		return diag.Errorf("error creating the new OPA version %s: %v", opts.Version, err)
 	}
 
 	d.SetId(v.ID)
 
-	return resourceTFEOPAVersionRead(d, meta)
+	return resourceTFEOPAVersionRead(ctx, d, meta)
 }
 
-func resourceTFEOPAVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOPAVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of OPA version: %s", d.Id())
@@ -102,7 +106,7 @@ func resourceTFEOPAVersionRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("version", v.Version)
@@ -117,7 +121,7 @@ func resourceTFEOPAVersionRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEOPAVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOPAVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	opts := tfe.AdminOPAVersionUpdateOptions{
@@ -134,15 +138,15 @@ func resourceTFEOPAVersionUpdate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Update configuration of OPA version: %s", d.Id())
 	v, err := config.Client.Admin.OPAVersions.Update(ctx, d.Id(), opts)
 	if err != nil {
-		return fmt.Errorf("error updating OPA version %s: %w", d.Id(), err)
+		return diag.Errorf("error updating OPA version %s: %v", d.Id(), err)
 	}
 
 	d.SetId(v.ID)
 
-	return resourceTFEOPAVersionRead(d, meta)
+	return resourceTFEOPAVersionRead(ctx, d, meta)
 }
 
-func resourceTFEOPAVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOPAVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete OPA version: %s", d.Id())
@@ -152,7 +156,7 @@ func resourceTFEOPAVersionDelete(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] OPA version: %s not found", d.Id())
 			return nil
 		}
-		return fmt.Errorf("error deleting OPA version %s: %w", d.Id(), err)
+		return diag.Errorf("error deleting OPA version %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -165,7 +169,7 @@ func resourceTFEOPAVersionImporter(ctx context.Context, d *schema.ResourceData,
 	// determines if the string is a tool version ID
 	s := strings.Split(d.Id(), "-")
 	if s[0] != "tool" {
-		versionID, err := fetchOPAVersionID(d.Id(), config.Client)
+		versionID, err := fetchOPAVersionID(ctx, d.Id(), config.Client)
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving OPA version %s: %w", d.Id(), err)
 		}
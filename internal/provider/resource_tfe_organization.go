@@ -9,25 +9,29 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEOrganization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationCreate,
-		Read:   resourceTFEOrganizationRead,
-		Update: resourceTFEOrganizationUpdate,
-		Delete: resourceTFEOrganizationDelete,
+		CreateContext: resourceTFEOrganizationCreate,
+		ReadContext:   resourceTFEOrganizationRead,
+		UpdateContext: resourceTFEOrganizationUpdate,
+		DeleteContext: resourceTFEOrganizationDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffCapabilitiesKey("tfe_organization", "name"),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -112,7 +116,7 @@ func resourceTFEOrganization() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization name.
@@ -127,15 +131,15 @@ func resourceTFEOrganizationCreate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Create new organization: %s", name)
 	org, err := config.Client.Organizations.Create(ctx, options)
 	if err != nil {
-		return fmt.Errorf("Error creating the new organization %s: %w", name, err)
+		return diag.Errorf("Error creating the new organization %s: %v", name, err)
 	}
 
 	d.SetId(org.Name)
 
-	return resourceTFEOrganizationUpdate(d, meta)
+	return resourceTFEOrganizationUpdate(ctx, d, meta)
 }
 
-func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of organization: %s", d.Id())
@@ -146,7 +150,7 @@ func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Update the config.
@@ -172,7 +176,7 @@ func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Create a new options struct.
@@ -236,15 +240,15 @@ func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Update configuration of organization: %s", d.Id())
 	org, err := config.Client.Organizations.Update(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error updating organization %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating organization %s: %v", d.Id(), err)
 	}
 
 	d.SetId(org.Name)
 
-	return resourceTFEOrganizationRead(d, meta)
+	return resourceTFEOrganizationRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete organization: %s", d.Id())
@@ -253,7 +257,7 @@ func resourceTFEOrganizationDelete(d *schema.ResourceData, meta interface{}) err
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting organization %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting organization %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -15,15 +15,17 @@ import (
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEOrganizationDefaultSettings() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationDefaultSettingsCreate,
-		Read:   resourceTFEOrganizationDefaultSettingsRead,
-		Delete: resourceTFEOrganizationDefaultSettingsDelete,
+		CreateContext: resourceTFEOrganizationDefaultSettingsCreate,
+		ReadContext:   resourceTFEOrganizationDefaultSettingsRead,
+		DeleteContext: resourceTFEOrganizationDefaultSettingsDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationDefaultSettingsImporter,
 		},
@@ -61,13 +63,13 @@ func resourceTFEOrganizationDefaultSettings() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationDefaultSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationDefaultSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization name.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return fmt.Errorf("error getting organization name: %w", err)
+		return diag.Errorf("error getting organization name: %v", err)
 	}
 
 	// If the "default_agent_pool_id" was provided, get the agent pool
@@ -82,24 +84,24 @@ func resourceTFEOrganizationDefaultSettingsCreate(d *schema.ResourceData, meta i
 	if v, ok := d.GetOk("default_execution_mode"); ok {
 		defaultExecutionMode = v.(string)
 	} else {
-		return fmt.Errorf("default_execution_mode was missing from tfstate, please create an issue to report this error")
+		return diag.Errorf("default_execution_mode was missing from tfstate, please create an issue to report this error")
 	}
 
 	// set organization default execution mode
-	_, err = config.Client.Organizations.Update(context.Background(), organization, tfe.OrganizationUpdateOptions{
+	_, err = config.Client.Organizations.Update(ctx, organization, tfe.OrganizationUpdateOptions{
 		DefaultExecutionMode: tfe.String(defaultExecutionMode),
 		DefaultAgentPool:     agentPool,
 	})
 	if err != nil {
-		return fmt.Errorf("error setting default execution mode of organization %s: %w", d.Id(), err)
+		return diag.Errorf("error setting default execution mode of organization %s: %v", d.Id(), err)
 	}
 
 	d.SetId(organization)
 
-	return resourceTFEOrganizationDefaultSettingsRead(d, meta)
+	return resourceTFEOrganizationDefaultSettingsRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationDefaultSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationDefaultSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read the organization: %s", d.Id())
@@ -110,14 +112,14 @@ func resourceTFEOrganizationDefaultSettingsRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading organization %s: %w", d.Id(), err)
+		return diag.Errorf("error reading organization %s: %v", d.Id(), err)
 	}
 
 	defaultExecutionMode := ""
 	if v, ok := d.GetOk("default_execution_mode"); ok {
 		defaultExecutionMode = v.(string)
 	} else {
-		return fmt.Errorf("default_execution_mode was missing from tfstate, please create an issue to report this error")
+		return diag.Errorf("default_execution_mode was missing from tfstate, please create an issue to report this error")
 	}
 	if organization.DefaultExecutionMode != defaultExecutionMode {
 		// set id to empty string so that the provider knows it needs to set the default execution mode again
@@ -127,23 +129,23 @@ func resourceTFEOrganizationDefaultSettingsRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTFEOrganizationDefaultSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationDefaultSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization name.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return fmt.Errorf("error getting organization name: %w", err)
+		return diag.Errorf("error getting organization name: %v", err)
 	}
 
 	log.Printf("[DEBUG] Reseting default execution mode of organization: %s", organization)
 	// reset organization default execution mode
-	_, err = config.Client.Organizations.Update(context.Background(), organization, tfe.OrganizationUpdateOptions{
+	_, err = config.Client.Organizations.Update(ctx, organization, tfe.OrganizationUpdateOptions{
 		DefaultExecutionMode: tfe.String("remote"),
 		DefaultAgentPool:     nil,
 	})
 	if err != nil {
-		return fmt.Errorf("error updating organization default execution mode: %w", err)
+		return diag.Errorf("error updating organization default execution mode: %v", err)
 	}
 
 	return nil
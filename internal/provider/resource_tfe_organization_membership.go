@@ -15,14 +15,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEOrganizationMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationMembershipCreate,
-		Read:   resourceTFEOrganizationMembershipRead,
-		Delete: resourceTFEOrganizationMembershipDelete,
+		CreateContext: resourceTFEOrganizationMembershipCreate,
+		ReadContext:   resourceTFEOrganizationMembershipRead,
+		DeleteContext: resourceTFEOrganizationMembershipDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationMembershipImporter,
 		},
@@ -56,14 +58,14 @@ func resourceTFEOrganizationMembership() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the email and organization.
 	email := d.Get("email").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -74,16 +76,16 @@ func resourceTFEOrganizationMembershipCreate(d *schema.ResourceData, meta interf
 	log.Printf("[DEBUG] Create membership %s for organization: %s", email, organization)
 	membership, err := config.Client.OrganizationMemberships.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating membership %s for organization %s: %w", email, organization, err)
+		return diag.Errorf(
+			"Error creating membership %s for organization %s: %v", email, organization, err)
 	}
 
 	d.SetId(membership.ID)
 
-	return resourceTFEOrganizationMembershipRead(d, meta)
+	return resourceTFEOrganizationMembershipRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	options := tfe.OrganizationMembershipReadOptions{
@@ -99,7 +101,7 @@ func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of membership %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of membership %s: %v", d.Id(), err)
 	}
 
 	d.Set("email", membership.Email)
@@ -110,7 +112,7 @@ func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTFEOrganizationMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete membership: %s", d.Id())
@@ -119,7 +121,7 @@ func resourceTFEOrganizationMembershipDelete(d *schema.ResourceData, meta interf
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting membership %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting membership %s: %v", d.Id(), err)
 	}
 
 	return nil
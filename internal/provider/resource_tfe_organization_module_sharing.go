@@ -9,23 +9,29 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEOrganizationModuleSharing() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "the tfe_organization_module_sharing resource is deprecated, please use tfe_admin_organization_settings instead",
-		Create:             resourceTFEOrganizationModuleSharingCreate,
-		Read:               resourceTFEOrganizationModuleSharingRead,
-		Update:             resourceTFEOrganizationModuleSharingUpdate,
-		Delete:             resourceTFEOrganizationModuleSharingDelete,
+		CreateContext:      resourceTFEOrganizationModuleSharingCreate,
+		ReadContext:        resourceTFEOrganizationModuleSharingRead,
+		UpdateContext:      resourceTFEOrganizationModuleSharingUpdate,
+		DeleteContext:      resourceTFEOrganizationModuleSharingDelete,
+		Timeouts:           resourceTimeouts(true),
 
-		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
+		CustomizeDiff: customdiff.All(
+			customizeDiffIfProviderDefaultOrganizationChanged,
+			customizeDiffCapabilities("tfe_organization_module_sharing"),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"organization": {
@@ -47,22 +53,22 @@ func resourceTFEOrganizationModuleSharing() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationModuleSharingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get the organization name that will share "produce" modules
 	config := meta.(ConfiguredClient)
 
 	producer, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Create %s module consumers", producer)
 	d.SetId(producer)
 
-	return resourceTFEOrganizationModuleSharingUpdate(d, meta)
+	return resourceTFEOrganizationModuleSharingUpdate(ctx, d, meta)
 }
 
-func resourceTFEOrganizationModuleSharingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var consumers []string
@@ -77,13 +83,13 @@ func resourceTFEOrganizationModuleSharingUpdate(d *schema.ResourceData, meta int
 	log.Printf("[DEBUG] Update %s module consumers", d.Id())
 	err := config.Client.Admin.Organizations.UpdateModuleConsumers(ctx, d.Id(), consumers)
 	if err != nil {
-		return fmt.Errorf("error updating module consumers to %s: %w", d.Id(), err)
+		return diag.Errorf("error updating module consumers to %s: %v", d.Id(), err)
 	}
 
-	return resourceTFEOrganizationModuleSharingRead(d, meta)
+	return resourceTFEOrganizationModuleSharingRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationModuleSharingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	options := &tfe.AdminOrganizationListModuleConsumersOptions{}
@@ -97,7 +103,7 @@ func resourceTFEOrganizationModuleSharingRead(d *schema.ResourceData, meta inter
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("Error reading organization %s module consumer list: %w", d.Id(), err)
+			return diag.Errorf("Error reading organization %s module consumer list: %v", d.Id(), err)
 		}
 
 		if consumerList.CurrentPage >= consumerList.TotalPages {
@@ -110,7 +116,7 @@ func resourceTFEOrganizationModuleSharingRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFEOrganizationModuleSharingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Disable module sharing for organization: %s", d.Id())
@@ -119,7 +125,7 @@ func resourceTFEOrganizationModuleSharingDelete(d *schema.ResourceData, meta int
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("failed to delete module sharing for organization %s: %w", d.Id(), err)
+		return diag.Errorf("failed to delete module sharing for organization %s: %v", d.Id(), err)
 	}
 
 	return nil
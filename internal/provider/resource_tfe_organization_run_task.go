@@ -155,6 +155,11 @@ func (r *resourceOrgRunTask) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	taskID := state.ID.ValueString()
 
 	tflog.Debug(ctx, "Reading organization run task")
@@ -221,6 +226,11 @@ func (r *resourceOrgRunTask) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state modelTFEOrganizationRunTaskV0
 	// Read Terraform state into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
@@ -265,6 +275,11 @@ func (r *resourceOrgRunTask) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	taskID := state.ID.ValueString()
 
 	tflog.Debug(ctx, fmt.Sprintf("Delete task %s", taskID))
@@ -292,7 +307,12 @@ func (r *resourceOrgRunTask) ImportState(ctx context.Context, req resource.Impor
 	taskName := s[1]
 	orgName := s[0]
 
-	if task, err := fetchOrganizationRunTask(taskName, orgName, r.config.Client); err != nil {
+	resp.Diagnostics.Append(r.config.useImportOrganization(orgName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if task, err := fetchOrganizationRunTask(ctx, taskName, orgName, r.config.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing organization run task",
 			err.Error(),
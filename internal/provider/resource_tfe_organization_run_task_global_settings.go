@@ -36,7 +36,7 @@ type modelDataTFEOrganizationRunTaskGlobalSettings struct {
 	TaskID           types.String `tfsdk:"task_id"`
 }
 
-func dataModelFromTFEOrganizationRunTaskGlobalSettings(v tfe.RunTask) modelDataTFEOrganizationRunTaskGlobalSettings {
+func dataModelFromTFEOrganizationRunTaskGlobalSettings(ctx context.Context, v tfe.RunTask) modelDataTFEOrganizationRunTaskGlobalSettings {
 	result := modelDataTFEOrganizationRunTaskGlobalSettings{
 		Enabled:          types.BoolNull(),
 		ID:               types.StringValue(v.ID),
@@ -165,6 +165,11 @@ func (r *resourceOrganizationRunTaskGlobalSettings) getRunTask(ctx context.Conte
 }
 
 func (r *resourceOrganizationRunTaskGlobalSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "task_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state modelDataTFEOrganizationRunTaskGlobalSettings
 
 	// Read Terraform current state into the model
@@ -180,17 +185,27 @@ func (r *resourceOrganizationRunTaskGlobalSettings) Read(ctx context.Context, re
 		return
 	}
 
-	result := dataModelFromTFEOrganizationRunTaskGlobalSettings(*task)
+	result := dataModelFromTFEOrganizationRunTaskGlobalSettings(ctx, *task)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 }
 
 func (r *resourceOrganizationRunTaskGlobalSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "task_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	r.updateRunTask(ctx, &req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *resourceOrganizationRunTaskGlobalSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "task_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	r.updateRunTask(ctx, &req.Plan, &resp.State, &resp.Diagnostics)
 }
 
@@ -235,12 +250,17 @@ func (r *resourceOrganizationRunTaskGlobalSettings) updateRunTask(ctx context.Co
 		diagnostics.AddError("Unable to update organization task", err.Error())
 		return
 	}
-	result := dataModelFromTFEOrganizationRunTaskGlobalSettings(*task)
+	result := dataModelFromTFEOrganizationRunTaskGlobalSettings(ctx, *task)
 
 	diagnostics.Append(tfState.Set(ctx, &result)...)
 }
 
 func (r *resourceOrganizationRunTaskGlobalSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "task_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state modelDataTFEOrganizationRunTaskGlobalSettings
 
 	// Read Terraform planned changes into the model
@@ -280,7 +300,7 @@ func (r *resourceOrganizationRunTaskGlobalSettings) ImportState(ctx context.Cont
 	taskName := s[1]
 	orgName := s[0]
 
-	if task, err := fetchOrganizationRunTask(taskName, orgName, r.config.Client); err != nil {
+	if task, err := fetchOrganizationRunTask(ctx, taskName, orgName, r.config.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing organization run task",
 			err.Error(),
@@ -292,7 +312,7 @@ func (r *resourceOrganizationRunTaskGlobalSettings) ImportState(ctx context.Cont
 		)
 	} else {
 		// We can never import the HMACkey (Write-only) so assume it's the default (empty)
-		result := dataModelFromTFEOrganizationRunTaskGlobalSettings(*task)
+		result := dataModelFromTFEOrganizationRunTaskGlobalSettings(ctx, *task)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 	}
 }
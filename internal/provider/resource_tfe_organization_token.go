@@ -11,19 +11,20 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEOrganizationToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationTokenCreate,
-		Read:   resourceTFEOrganizationTokenRead,
-		Delete: resourceTFEOrganizationTokenDelete,
+		CreateContext: resourceTFEOrganizationTokenCreate,
+		ReadContext:   resourceTFEOrganizationTokenRead,
+		DeleteContext: resourceTFEOrganizationTokenDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationTokenImporter,
 		},
@@ -59,25 +60,25 @@ func resourceTFEOrganizationToken() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization name.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Check if a token already exists for organization: %s", organization)
 	_, err = config.Client.OrganizationTokens.Read(ctx, organization)
 	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-		return fmt.Errorf("error checking if a token exists for organization %s: %w", organization, err)
+		return diag.Errorf("error checking if a token exists for organization %s: %v", organization, err)
 	}
 
 	// If error is nil, the token already exists.
 	if err == nil {
 		if !d.Get("force_regenerate").(bool) {
-			return fmt.Errorf("a token already exists for organization: %s", organization)
+			return diag.Errorf("a token already exists for organization: %s", organization)
 		}
 		log.Printf("[DEBUG] Regenerating existing token for organization: %s", organization)
 	}
@@ -95,14 +96,14 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 		options.ExpiredAt = &expiry
 
 		if err != nil {
-			return fmt.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt)
+			return diag.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt)
 		}
 	}
 
 	token, err := config.Client.OrganizationTokens.CreateWithOptions(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"error creating new token for organization %s: %w", organization, err)
+		return diag.Errorf(
+			"error creating new token for organization %s: %v", organization, err)
 	}
 
 	d.SetId(organization)
@@ -111,10 +112,10 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 	// only be returned once during the creation of the token.
 	d.Set("token", token.Token)
 
-	return resourceTFEOrganizationTokenRead(d, meta)
+	return resourceTFEOrganizationTokenRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read the token from organization: %s", d.Id())
@@ -125,19 +126,19 @@ func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading token from organization %s: %w", d.Id(), err)
+		return diag.Errorf("error reading token from organization %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceTFEOrganizationTokenDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization name.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Delete token from organization: %s", organization)
@@ -146,7 +147,7 @@ func resourceTFEOrganizationTokenDelete(d *schema.ResourceData, meta interface{}
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("error deleting token from organization %s: %w", d.Id(), err)
+		return diag.Errorf("error deleting token from organization %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -16,16 +16,18 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEPolicyCreate,
-		Read:   resourceTFEPolicyRead,
-		Update: resourceTFEPolicyUpdate,
-		Delete: resourceTFEPolicyDelete,
+		CreateContext: resourceTFEPolicyCreate,
+		ReadContext:   resourceTFEPolicyRead,
+		UpdateContext: resourceTFEPolicyUpdate,
+		DeleteContext: resourceTFEPolicyDelete,
+		Timeouts:      resourceTimeouts(true),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEPolicyImporter,
@@ -124,14 +126,14 @@ func opaPolicyEnforcementLevels() []string {
 	}
 }
 
-func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var kind string
@@ -160,13 +162,13 @@ func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 			"unsupported policy kind %s: has to be one of [%s, %s]", kind, string(tfe.Sentinel), string(tfe.OPA))
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] Create %s policy %s for organization: %s", kind, name, organization)
 	policy, err := config.Client.Policies.Create(ctx, organization, *options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating %s policy %s for organization %s: %w", kind, name, organization, err)
+		return diag.Errorf(
+			"Error creating %s policy %s for organization %s: %v", kind, name, organization, err)
 	}
 
 	d.SetId(policy.ID)
@@ -174,11 +176,11 @@ func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Upload %s policy %s for organization: %s", kind, name, organization)
 	err = config.Client.Policies.Upload(ctx, policy.ID, []byte(d.Get("policy").(string)))
 	if err != nil {
-		return fmt.Errorf(
-			"Error uploading %s policy %s for organization %s: %w", kind, name, organization, err)
+		return diag.Errorf(
+			"Error uploading %s policy %s for organization %s: %v", kind, name, organization, err)
 	}
 
-	return resourceTFEPolicyRead(d, meta)
+	return resourceTFEPolicyRead(ctx, d, meta)
 }
 
 func createOPAPolicyOptions(options *tfe.PolicyCreateOptions, d *schema.ResourceData) (*tfe.PolicyCreateOptions, error) {
@@ -235,7 +237,7 @@ func getDefaultEnforcementMode(kind tfe.PolicyKind) tfe.EnforcementLevel {
 	}
 }
 
-func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read policy: %s", d.Id())
@@ -246,7 +248,7 @@ func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading Policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading Policy %s: %v", d.Id(), err)
 	}
 
 	// Update the config.
@@ -261,14 +263,14 @@ func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	content, err := config.Client.Policies.Download(ctx, policy.ID)
 	if err != nil {
-		return fmt.Errorf("Error downloading policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error downloading policy %s: %v", d.Id(), err)
 	}
 	d.Set("policy", string(content))
 
 	return nil
 }
 
-func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var kind string
@@ -306,8 +308,8 @@ func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Update configuration for %s policy: %s", kind, d.Id())
 		_, err := config.Client.Policies.Update(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating configuration for %s policy %s: %w", kind, d.Id(), err)
+			return diag.Errorf(
+				"Error updating configuration for %s policy %s: %v", kind, d.Id(), err)
 		}
 	}
 
@@ -316,14 +318,14 @@ func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Update %s policy: %s", vKind, d.Id())
 		err := config.Client.Policies.Upload(ctx, d.Id(), []byte(d.Get("policy").(string)))
 		if err != nil {
-			return fmt.Errorf("Error updating %s policy %s: %w", vKind, d.Id(), err)
+			return diag.Errorf("Error updating %s policy %s: %v", vKind, d.Id(), err)
 		}
 	}
 
-	return resourceTFEPolicyRead(d, meta)
+	return resourceTFEPolicyRead(ctx, d, meta)
 }
 
-func resourceTFEPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete policy: %s", d.Id())
@@ -332,7 +334,7 @@ func resourceTFEPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 		if errors.Is(err, tfe.ErrResourceNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting policy %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -342,7 +344,7 @@ func resourceTFEPolicyImporter(ctx context.Context, d *schema.ResourceData, meta
 	s := strings.SplitN(d.Id(), "/", 2)
 	if len(s) != 2 {
 		return nil, fmt.Errorf(
-			"invalid policy import format: %s (expected <ORGANIZATION>/<POLICY ID>)",
+			"invalid policy import format: %s (expected <ORGANIZATION>/<POLICY ID> or <ORGANIZATION>/<POLICY NAME>)",
 			d.Id(),
 		)
 	}
@@ -351,5 +353,18 @@ func resourceTFEPolicyImporter(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("organization", s[0])
 	d.SetId(s[1])
 
+	if !isResourceIDFormat("pol", s[1]) {
+		config := meta.(ConfiguredClient)
+		if err := config.useOrganization(s[0]); err != nil {
+			return nil, err
+		}
+		policy, err := fetchPolicyByName(ctx, config.Client, s[0], s[1])
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving policy with name %s from organization %s: %w", s[1], s[0], err)
+		}
+		d.SetId(policy.ID)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
@@ -9,23 +9,26 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEPolicySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEPolicySetCreate,
-		Read:   resourceTFEPolicySetRead,
-		Update: resourceTFEPolicySetUpdate,
-		Delete: resourceTFEPolicySetDelete,
+		CreateContext: resourceTFEPolicySetCreate,
+		ReadContext:   resourceTFEPolicySetRead,
+		UpdateContext: resourceTFEPolicySetUpdate,
+		DeleteContext: resourceTFEPolicySetDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByIDOrName("policy set", fetchPolicySetID),
 		},
 
 		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
@@ -161,13 +164,13 @@ func resourceTFEPolicySet() *schema.Resource {
 	}
 }
 
-func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -229,24 +232,24 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create policy set %s for organization: %s", name, organization)
 	policySet, err := config.Client.PolicySets.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating policy set %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating policy set %s for organization %s: %v", name, organization, err)
 	}
 	_, hasVCSRepo := d.GetOk("vcs_repo")
 	_, hasSlug := d.GetOk("slug")
 	if hasSlug && !hasVCSRepo {
-		err := resourceTFEPolicySetUploadVersion(config.Client, d, policySet.ID)
+		err := resourceTFEPolicySetUploadVersion(ctx, config.Client, d, policySet.ID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(policySet.ID)
 
-	return resourceTFEPolicySetRead(d, meta)
+	return resourceTFEPolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read policy set: %s", d.Id())
@@ -257,7 +260,7 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading policy set %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading policy set %s: %v", d.Id(), err)
 	}
 
 	// Update the config.
@@ -328,7 +331,7 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	name := d.Get("name").(string)
@@ -351,7 +354,7 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Removing previous workspaces from now-global policy set: %s", d.Id())
 			err := config.Client.PolicySets.RemoveWorkspaces(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error detaching policy set %s from workspaces: %w", d.Id(), err)
+				return diag.Errorf("Error detaching policy set %s from workspaces: %v", d.Id(), err)
 			}
 		}
 	}
@@ -397,8 +400,8 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 		log.Printf("[DEBUG] Update configuration for policy set: %s", d.Id())
 		_, err := config.Client.PolicySets.Update(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating configuration for policy set %s: %w", d.Id(), err)
+			return diag.Errorf(
+				"Error updating configuration for policy set %s: %v", d.Id(), err)
 		}
 	}
 
@@ -418,7 +421,7 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Add policies to policy set: %s", d.Id())
 			err := config.Client.PolicySets.AddPolicies(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error adding policies to policy set %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding policies to policy set %s: %v", d.Id(), err)
 			}
 		}
 
@@ -433,16 +436,16 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Remove policies from policy set: %s", d.Id())
 			err := config.Client.PolicySets.RemovePolicies(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error removing policies from policy set %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing policies from policy set %s: %v", d.Id(), err)
 			}
 		}
 	}
 
 	_, hasVCSRepo := d.GetOk("vcs_repo")
 	if d.HasChange("slug") && !hasVCSRepo {
-		err := resourceTFEPolicySetUploadVersion(config.Client, d, d.Id())
+		err := resourceTFEPolicySetUploadVersion(ctx, config.Client, d, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -465,7 +468,7 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Attach policy set to workspaces: %s", d.Id())
 			err := config.Client.PolicySets.AddWorkspaces(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error attaching policy set %s to workspaces: %w", d.Id(), err)
+				return diag.Errorf("Error attaching policy set %s to workspaces: %v", d.Id(), err)
 			}
 		}
 
@@ -480,15 +483,15 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Detach policy set from workspaces: %s", d.Id())
 			err := config.Client.PolicySets.RemoveWorkspaces(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error detaching policy set %s from workspaces: %w", d.Id(), err)
+				return diag.Errorf("Error detaching policy set %s from workspaces: %v", d.Id(), err)
 			}
 		}
 	}
 
-	return resourceTFEPolicySetRead(d, meta)
+	return resourceTFEPolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete policy set: %s", d.Id())
@@ -497,13 +500,13 @@ func resourceTFEPolicySetDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting policy set %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting policy set %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceTFEPolicySetUploadVersion(client *tfe.Client, d *schema.ResourceData, policySetID string) error {
+func resourceTFEPolicySetUploadVersion(ctx context.Context, client *tfe.Client, d *schema.ResourceData, policySetID string) error {
 	log.Printf("[DEBUG] Create policy set version for policy set %s.", policySetID)
 	psv, err := client.PolicySetVersions.Create(ctx, policySetID)
 	if err != nil {
@@ -15,15 +15,17 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEPolicySetParameter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEPolicySetParameterCreate,
-		Read:   resourceTFEPolicySetParameterRead,
-		Update: resourceTFEPolicySetParameterUpdate,
-		Delete: resourceTFEPolicySetParameterDelete,
+		CreateContext: resourceTFEPolicySetParameterCreate,
+		ReadContext:   resourceTFEPolicySetParameterRead,
+		UpdateContext: resourceTFEPolicySetParameterUpdate,
+		DeleteContext: resourceTFEPolicySetParameterDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEPolicySetParameterImporter,
 		},
@@ -56,7 +58,7 @@ func resourceTFEPolicySetParameter() *schema.Resource {
 	}
 }
 
-func resourceTFEPolicySetParameterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetParameterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get key
@@ -65,7 +67,7 @@ func resourceTFEPolicySetParameterCreate(d *schema.ResourceData, meta interface{
 	ps := d.Get("policy_set_id").(string)
 	policySet, err := config.Client.PolicySets.Read(ctx, ps)
 	if err != nil {
-		return fmt.Errorf("Error retrieving policy set %s: %w", ps, err)
+		return diag.Errorf("Error retrieving policy set %s: %v", ps, err)
 	}
 
 	// Create a new options struct.
@@ -79,21 +81,21 @@ func resourceTFEPolicySetParameterCreate(d *schema.ResourceData, meta interface{
 	log.Printf("[DEBUG] Create %s parameter: %s", tfe.CategoryPolicySet, key)
 	parameter, err := config.Client.PolicySetParameters.Create(ctx, policySet.ID, options)
 	if err != nil {
-		return fmt.Errorf("Error creating %s parameter %s %w", tfe.CategoryPolicySet, key, err)
+		return diag.Errorf("Error creating %s parameter %s %v", tfe.CategoryPolicySet, key, err)
 	}
 
 	d.SetId(parameter.ID)
 
-	return resourceTFEPolicySetParameterRead(d, meta)
+	return resourceTFEPolicySetParameterRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetParameterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetParameterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	ps := d.Get("policy_set_id").(string)
 	policySet, err := config.Client.PolicySets.Read(ctx, ps)
 	if err != nil {
-		return fmt.Errorf("Error retrieving policy set %s: %w", ps, err)
+		return diag.Errorf("Error retrieving policy set %s: %v", ps, err)
 	}
 
 	log.Printf("[DEBUG] Read parameter: %s", d.Id())
@@ -104,7 +106,7 @@ func resourceTFEPolicySetParameterRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading parameter %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading parameter %s: %v", d.Id(), err)
 	}
 
 	// Update config.
@@ -119,13 +121,13 @@ func resourceTFEPolicySetParameterRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEPolicySetParameterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetParameterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	ps := d.Get("policy_set_id").(string)
 	policySet, err := config.Client.PolicySets.Read(ctx, ps)
 	if err != nil {
-		return fmt.Errorf("Error retrieving policy set %s: %w", ps, err)
+		return diag.Errorf("Error retrieving policy set %s: %v", ps, err)
 	}
 
 	// Create a new options struct.
@@ -138,19 +140,19 @@ func resourceTFEPolicySetParameterUpdate(d *schema.ResourceData, meta interface{
 	log.Printf("[DEBUG] Update parameter: %s", d.Id())
 	_, err = config.Client.PolicySetParameters.Update(ctx, policySet.ID, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error updating parameter %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating parameter %s: %v", d.Id(), err)
 	}
 
-	return resourceTFEPolicySetParameterRead(d, meta)
+	return resourceTFEPolicySetParameterRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetParameterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetParameterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	ps := d.Get("policy_set_id").(string)
 	policySet, err := config.Client.PolicySets.Read(ctx, ps)
 	if err != nil {
-		return fmt.Errorf("Error retrieving policy set %s: %w", ps, err)
+		return diag.Errorf("Error retrieving policy set %s: %v", ps, err)
 	}
 
 	log.Printf("[DEBUG] Delete parameter: %s", d.Id())
@@ -159,24 +161,48 @@ func resourceTFEPolicySetParameterDelete(d *schema.ResourceData, meta interface{
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting parameter %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting parameter %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
 func resourceTFEPolicySetParameterImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	s := strings.SplitN(d.Id(), "/", 2)
-	if len(s) != 2 {
+	config := meta.(ConfiguredClient)
+
+	// Import formats:
+	//  - <POLICY SET ID>/<PARAMETER ID>
+	//  - <ORGANIZATION NAME>/<POLICY SET NAME>/<PARAMETER KEY>
+	s := strings.SplitN(d.Id(), "/", 3)
+	switch len(s) {
+	case 2:
+		// Set the fields that are part of the import ID.
+		d.Set("policy_set_id", s[0])
+		d.SetId(s[1])
+	case 3:
+		org, policySetName, key := s[0], s[1], s[2]
+		if err := config.useOrganization(org); err != nil {
+			return nil, err
+		}
+		policySet, err := fetchPolicySetByName(ctx, config.Client, org, policySetName)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving policy set with name %s from organization %s: %w", policySetName, org, err)
+		}
+		parameter, err := fetchPolicySetParameterByKey(ctx, config.Client, policySet.ID, key)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving parameter with key %s from policy set %s: %w", key, policySet.ID, err)
+		}
+
+		d.Set("policy_set_id", policySet.ID)
+		d.SetId(parameter.ID)
+	default:
 		return nil, fmt.Errorf(
-			"invalid parameter import format: %s (expected <POLICY SET ID>/<PARAMETER ID>)",
+			"invalid parameter import format: %s (expected <POLICY SET ID>/<PARAMETER ID> or <ORGANIZATION>/<POLICY SET NAME>/<PARAMETER KEY>)",
 			d.Id(),
 		)
 	}
 
-	// Set the fields that are part of the import ID.
-	d.Set("policy_set_id", s[0])
-	d.SetId(s[1])
-
 	return []*schema.ResourceData{d}, nil
 }
@@ -17,6 +17,7 @@ import (
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -27,11 +28,15 @@ func resourceTFEProject() *schema.Resource {
 		ReadContext:   resourceTFEProjectRead,
 		UpdateContext: resourceTFEProjectUpdate,
 		DeleteContext: resourceTFEProjectDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByIDOrName("project", fetchProjectID),
 		},
 
-		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
+		CustomizeDiff: customdiff.All(
+			customizeDiffIfProviderDefaultOrganizationChanged,
+			customizeDiffCapabilities("tfe_project"),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
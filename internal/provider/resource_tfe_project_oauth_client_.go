@@ -11,14 +11,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEProjectOAuthClient() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEProjectOauthClientCreate,
-		Read:   resourceTFEProjectOauthClientRead,
-		Delete: resourceTFEProjectOauthClientDelete,
+		CreateContext: resourceTFEProjectOauthClientCreate,
+		ReadContext:   resourceTFEProjectOauthClientRead,
+		DeleteContext: resourceTFEProjectOauthClientDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEProjectOauthClientImporter,
 		},
@@ -39,7 +41,7 @@ func resourceTFEProjectOAuthClient() *schema.Resource {
 	}
 }
 
-func resourceTFEProjectOauthClientCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectOauthClientCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	oauthClientID := d.Get("oauth_client_id").(string)
@@ -50,16 +52,16 @@ func resourceTFEProjectOauthClientCreate(d *schema.ResourceData, meta interface{
 
 	err := config.Client.OAuthClients.AddProjects(ctx, oauthClientID, oauthClientAddProjectsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error attaching oauth client id %s to project %s: %w", oauthClientID, projectID, err)
+		return diag.Errorf(
+			"error attaching oauth client id %s to project %s: %v", oauthClientID, projectID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s", projectID, oauthClientID))
 
-	return resourceTFEProjectOauthClientRead(d, meta)
+	return resourceTFEProjectOauthClientRead(ctx, d, meta)
 }
 
-func resourceTFEProjectOauthClientRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectOauthClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	oauthClientID := d.Get("oauth_client_id").(string)
@@ -75,7 +77,7 @@ func resourceTFEProjectOauthClientRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading configuration of oauth client %s: %w", oauthClientID, err)
+		return diag.Errorf("error reading configuration of oauth client %s: %v", oauthClientID, err)
 	}
 
 	isProjectAttached := false
@@ -97,7 +99,7 @@ func resourceTFEProjectOauthClientRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEProjectOauthClientDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectOauthClientDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	oauthClientID := d.Get("oauth_client_id").(string)
@@ -109,29 +111,37 @@ func resourceTFEProjectOauthClientDelete(d *schema.ResourceData, meta interface{
 
 	err := config.Client.OAuthClients.RemoveProjects(ctx, oauthClientID, oauthClientRemoveProjectsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error detaching project %s from oauth client %s: %w", projectID, oauthClientID, err)
+		return diag.Errorf(
+			"error detaching project %s from oauth client %s: %v", projectID, oauthClientID, err)
 	}
 
 	return nil
 }
 
 func resourceTFEProjectOauthClientImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	// The format of the import ID is <ORGANIZATION/PROJECT ID/OAUTHCLIENT NAME>
+	// The format of the import ID is <ORGANIZATION/PROJECT ID OR NAME/OAUTHCLIENT NAME>
 	splitID := strings.SplitN(d.Id(), "/", 3)
 	if len(splitID) != 3 {
 		return nil, fmt.Errorf(
-			"invalid project oauth client input format: %s (expected <ORGANIZATION>/<PROJECT ID>/<OAUTHCLIENT NAME>)",
+			"invalid project oauth client input format: %s (expected <ORGANIZATION>/<PROJECT ID OR NAME>/<OAUTHCLIENT NAME>)",
 			splitID,
 		)
 	}
 
-	organization, projectID, oauthClientName := splitID[0], splitID[1], splitID[2]
+	organization, oauthClientName := splitID[0], splitID[2]
 
 	config := meta.(ConfiguredClient)
+	if err := config.useOrganization(organization); err != nil {
+		return nil, err
+	}
+
+	projectID, err := fetchProjectExternalID(ctx, organization, splitID[1], config.Client)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving project %s in organization %s: %w", splitID[1], organization, err)
+	}
 
 	// Ensure the named project exists before fetching all the oauth clients in the org
-	_, err := config.Client.Projects.Read(ctx, projectID)
+	_, err = config.Client.Projects.Read(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("error reading configuration of project %s in organization %s: %w", projectID, organization, err)
 	}
@@ -16,14 +16,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEProjectPolicySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEProjectPolicySetCreate,
-		Read:   resourceTFEProjectPolicySetRead,
-		Delete: resourceTFEProjectPolicySetDelete,
+		CreateContext: resourceTFEProjectPolicySetCreate,
+		ReadContext:   resourceTFEProjectPolicySetRead,
+		DeleteContext: resourceTFEProjectPolicySetDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEProjectPolicySetImporter,
 		},
@@ -44,7 +46,7 @@ func resourceTFEProjectPolicySet() *schema.Resource {
 	}
 }
 
-func resourceTFEProjectPolicySetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectPolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -55,16 +57,16 @@ func resourceTFEProjectPolicySetCreate(d *schema.ResourceData, meta interface{})
 
 	err := config.Client.PolicySets.AddProjects(ctx, policySetID, policySetAddProjectsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error attaching policy set id %s to project %s: %w", policySetID, projectID, err)
+		return diag.Errorf(
+			"error attaching policy set id %s to project %s: %v", policySetID, projectID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s", projectID, policySetID))
 
-	return resourceTFEProjectPolicySetRead(d, meta)
+	return resourceTFEProjectPolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEProjectPolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -80,7 +82,7 @@ func resourceTFEProjectPolicySetRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading configuration of policy set %s: %w", policySetID, err)
+		return diag.Errorf("error reading configuration of policy set %s: %v", policySetID, err)
 	}
 
 	isProjectAttached := false
@@ -102,7 +104,7 @@ func resourceTFEProjectPolicySetRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTFEProjectPolicySetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectPolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -114,29 +116,37 @@ func resourceTFEProjectPolicySetDelete(d *schema.ResourceData, meta interface{})
 
 	err := config.Client.PolicySets.RemoveProjects(ctx, policySetID, policySetRemoveProjectsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error detaching project %s from policy set %s: %w", projectID, policySetID, err)
+		return diag.Errorf(
+			"error detaching project %s from policy set %s: %v", projectID, policySetID, err)
 	}
 
 	return nil
 }
 
 func resourceTFEProjectPolicySetImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	// The format of the import ID is <ORGANIZATION/PROJECT ID/POLICYSET NAME>
+	// The format of the import ID is <ORGANIZATION/PROJECT ID OR NAME/POLICYSET NAME>
 	splitID := strings.SplitN(d.Id(), "/", 3)
 	if len(splitID) != 3 {
 		return nil, fmt.Errorf(
-			"invalid project policy set input format: %s (expected <ORGANIZATION>/<PROJECT ID>/<POLICYSET NAME>)",
+			"invalid project policy set input format: %s (expected <ORGANIZATION>/<PROJECT ID OR NAME>/<POLICYSET NAME>)",
 			splitID,
 		)
 	}
 
-	organization, projectID, policySetName := splitID[0], splitID[1], splitID[2]
+	organization, policySetName := splitID[0], splitID[2]
 
 	config := meta.(ConfiguredClient)
+	if err := config.useOrganization(organization); err != nil {
+		return nil, err
+	}
+
+	projectID, err := fetchProjectExternalID(ctx, organization, splitID[1], config.Client)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving project %s in organization %s: %w", splitID[1], organization, err)
+	}
 
 	// Ensure the named project exists before fetching all the policy sets in the org
-	_, err := config.Client.Projects.Read(ctx, projectID)
+	_, err = config.Client.Projects.Read(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("error reading configuration of project %s in organization %s: %w", projectID, organization, err)
 	}
@@ -125,6 +125,12 @@ func TestAccTFEProject_import(t *testing.T) {
 				ImportStateId:     project.ID,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "tfe_project.foobar",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("tst-terraform-%d/projecttest", rInt),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -16,14 +16,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEProjectVariableSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEProjectVariableSetCreate,
-		Read:   resourceTFEProjectVariableSetRead,
-		Delete: resourceTFEProjectVariableSetDelete,
+		CreateContext: resourceTFEProjectVariableSetCreate,
+		ReadContext:   resourceTFEProjectVariableSetRead,
+		DeleteContext: resourceTFEProjectVariableSetDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEProjectVariableSetImporter,
 		},
@@ -44,7 +46,7 @@ func resourceTFEProjectVariableSet() *schema.Resource {
 	}
 }
 
-func resourceTFEProjectVariableSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	vSID := d.Get("variable_set_id").(string)
@@ -55,17 +57,17 @@ func resourceTFEProjectVariableSetCreate(d *schema.ResourceData, meta interface{
 
 	err := config.Client.VariableSets.ApplyToProjects(ctx, vSID, applyOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error applying variable set id %s to project %s: %w", vSID, prjID, err)
+		return diag.Errorf(
+			"Error applying variable set id %s to project %s: %v", vSID, prjID, err)
 	}
 
 	id := encodeVariableSetProjectAttachment(prjID, vSID)
 	d.SetId(id)
 
-	return resourceTFEProjectVariableSetRead(d, meta)
+	return resourceTFEProjectVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEProjectVariableSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	prjID := d.Get("project_id").(string)
@@ -81,7 +83,7 @@ func resourceTFEProjectVariableSetRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of variable set %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of variable set %s: %v", d.Id(), err)
 	}
 
 	// Verify project listed in variable set
@@ -103,7 +105,7 @@ func resourceTFEProjectVariableSetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEProjectVariableSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	prjID := d.Get("project_id").(string)
@@ -115,8 +117,8 @@ func resourceTFEProjectVariableSetDelete(d *schema.ResourceData, meta interface{
 
 	err := config.Client.VariableSets.RemoveFromProjects(ctx, vSID, removeOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error removing project %s from variable set %s: %w", prjID, vSID, err)
+		return diag.Errorf(
+			"Error removing project %s from variable set %s: %v", prjID, vSID, err)
 	}
 
 	return nil
@@ -127,15 +129,23 @@ func encodeVariableSetProjectAttachment(prjID, vSID string) string {
 }
 
 func resourceTFEProjectVariableSetImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	// The format of the import ID is <ORGANIZATION/PROJECT ID/VARSET NAME> but be aware
+	// The format of the import ID is <ORGANIZATION/PROJECT ID OR NAME/VARSET NAME> but be aware
 	// that variable set names can contain forward slash characters but organization/project
 	// names cannot. Therefore, we split the import ID into at most 3 substrings.
-	organization, prjID, vSName, err := destructureProjectImportID(strings.SplitN(d.Id(), "/", 3))
+	organization, prjIDOrName, vSName, err := destructureProjectImportID(strings.SplitN(d.Id(), "/", 3))
 	if err != nil {
 		return nil, err
 	}
 
 	config := meta.(ConfiguredClient)
+	if err := config.useOrganization(organization); err != nil {
+		return nil, err
+	}
+
+	prjID, err := fetchProjectExternalID(ctx, organization, prjIDOrName, config.Client)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving project %s in organization %s: %w", prjIDOrName, organization, err)
+	}
 
 	// Ensure a project with this ID exists before fetching all the variable sets in the org
 	_, err = config.Client.Projects.Read(ctx, prjID)
@@ -176,7 +186,7 @@ func resourceTFEProjectVariableSetImporter(ctx context.Context, d *schema.Resour
 func destructureProjectImportID(splitID []string) (string, string, string, error) {
 	if len(splitID) != 3 {
 		return "", "", "", fmt.Errorf(
-			"invalid project variable set input format: %s (expected <ORGANIZATION>/<PROJECT ID>/<VARIABLE SET NAME>)",
+			"invalid project variable set input format: %s (expected <ORGANIZATION>/<PROJECT ID OR NAME>/<VARIABLE SET NAME>)",
 			splitID,
 		)
 	}
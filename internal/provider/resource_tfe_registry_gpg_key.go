@@ -195,6 +195,11 @@ func (r *resourceTFERegistryGPGKey) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	keyID := tfe.GPGKeyID{
 		RegistryName: "private",
 		Namespace:    state.Organization.ValueString(),
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,10 +25,11 @@ import (
 
 func resourceTFERegistryModule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFERegistryModuleCreate,
-		Read:   resourceTFERegistryModuleRead,
-		Update: resourceTFERegistryModuleUpdate,
-		Delete: resourceTFERegistryModuleDelete,
+		CreateContext: resourceTFERegistryModuleCreate,
+		ReadContext:   resourceTFERegistryModuleRead,
+		UpdateContext: resourceTFERegistryModuleUpdate,
+		DeleteContext: resourceTFERegistryModuleDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFERegistryModuleImporter,
 		},
@@ -147,7 +149,7 @@ func resourceTFERegistryModule() *schema.Resource {
 	}
 }
 
-func resourceTFERegistryModuleCreateWithVCS(v interface{}, meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
+func resourceTFERegistryModuleCreateWithVCS(ctx context.Context, v interface{}, meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
 	config := meta.(ConfiguredClient)
 	// Create module with VCS repo configuration block.
 	options := tfe.RegistryModuleCreateWithVCSConnectionOptions{}
@@ -208,7 +210,7 @@ func resourceTFERegistryModuleCreateWithVCS(v interface{}, meta interface{}, d *
 	return registryModule, nil
 }
 
-func resourceTFERegistryModuleCreateWithoutVCS(meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
+func resourceTFERegistryModuleCreateWithoutVCS(ctx context.Context, meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
 	config := meta.(ConfiguredClient)
 
 	options := tfe.RegistryModuleCreateOptions{
@@ -241,22 +243,22 @@ func resourceTFERegistryModuleCreateWithoutVCS(meta interface{}, d *schema.Resou
 	return registryModule, nil
 }
 
-func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERegistryModuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 	var registryModule *tfe.RegistryModule
 	var err error
 
 	if v, ok := d.GetOk("vcs_repo"); ok {
-		registryModule, err = resourceTFERegistryModuleCreateWithVCS(v, meta, d)
+		registryModule, err = resourceTFERegistryModuleCreateWithVCS(ctx, v, meta, d)
 	} else {
-		registryModule, err = resourceTFERegistryModuleCreateWithoutVCS(meta, d)
+		registryModule, err = resourceTFERegistryModuleCreateWithoutVCS(ctx, meta, d)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(time.Duration(5)*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, time.Duration(5)*time.Minute, func() *resource.RetryError {
 		rmID := tfe.RegistryModuleID{
 			Organization: registryModule.Organization.Name,
 			Name:         registryModule.Name,
@@ -275,7 +277,7 @@ func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error while waiting for module %s/%s to be ingested: %w", registryModule.Organization.Name, registryModule.Name, err)
+		return diag.Errorf("Error while waiting for module %s/%s to be ingested: %v", registryModule.Organization.Name, registryModule.Name, err)
 	}
 
 	d.SetId(registryModule.ID)
@@ -287,10 +289,10 @@ func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) e
 	d.Set("namespace", registryModule.Namespace)
 	d.Set("registry_name", registryModule.RegistryName)
 
-	return resourceTFERegistryModuleRead(d, meta)
+	return resourceTFERegistryModuleRead(ctx, d, meta)
 }
 
-func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERegistryModuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	options := tfe.RegistryModuleUpdateOptions{}
@@ -328,7 +330,7 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if v, ok := d.GetOk("test_config"); ok {
 		if v.([]interface{})[0] == nil {
-			return fmt.Errorf("tests_enabled must be provided when configuring a test_config")
+			return diag.Errorf("tests_enabled must be provided when configuring a test_config")
 		}
 
 		testConfig := v.([]interface{})[0].(map[string]interface{})
@@ -340,7 +342,7 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	err = resource.Retry(time.Duration(5)*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, time.Duration(5)*time.Minute, func() *resource.RetryError {
 		registryModule, err = config.Client.RegistryModules.Update(ctx, rmID, options)
 		if err != nil {
 			return resource.RetryableError(err)
@@ -349,15 +351,15 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error while waiting for module %s/%s to be updated: %w", rmID.Organization, rmID.Name, err)
+		return diag.Errorf("Error while waiting for module %s/%s to be updated: %v", rmID.Organization, rmID.Name, err)
 	}
 
 	d.SetId(registryModule.ID)
 
-	return resourceTFERegistryModuleRead(d, meta)
+	return resourceTFERegistryModuleRead(ctx, d, meta)
 }
 
-func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERegistryModuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read registry module: %s", d.Id())
@@ -378,7 +380,7 @@ func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading registry module %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading registry module %s: %v", d.Id(), err)
 	}
 
 	// Update the config
@@ -421,7 +423,7 @@ func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceTFERegistryModuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERegistryModuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Fields required to delete registry module by provider
@@ -439,14 +441,14 @@ func resourceTFERegistryModuleDelete(d *schema.ResourceData, meta interface{}) e
 
 		err := config.Client.RegistryModules.DeleteProvider(ctx, rModID)
 		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-			return fmt.Errorf("error deleting registry module provider: %w", err)
+			return diag.Errorf("error deleting registry module provider: %v", err)
 		}
 	} else {
 		log.Printf("[DEBUG] Delete registry module by name: %s", d.Id())
 
 		err := config.Client.RegistryModules.DeleteByName(ctx, rModID)
 		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-			return fmt.Errorf("Error deleting registry module %s: %w", d.Id(), err)
+			return diag.Errorf("Error deleting registry module %s: %v", d.Id(), err)
 		}
 	}
 
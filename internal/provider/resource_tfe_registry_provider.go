@@ -283,6 +283,11 @@ func (r *resourceTFERegistryProvider) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	registryName := state.RegistryName.ValueString()
 
 	providerID := tfe.RegistryProviderID{
@@ -9,23 +9,26 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFERunTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFERunTriggerCreate,
-		Read:   resourceTFERunTriggerRead,
-		Delete: resourceTFERunTriggerDelete,
+		CreateContext: resourceTFERunTriggerCreate,
+		ReadContext:   resourceTFERunTriggerRead,
+		DeleteContext: resourceTFERunTriggerDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTFERunTriggerImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,7 +46,7 @@ func resourceTFERunTrigger() *schema.Resource {
 	}
 }
 
-func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERunTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get attributes
@@ -58,7 +61,7 @@ func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Create run trigger on workspace %s with sourceable %s", workspaceID, sourceableID)
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		runTrigger, err := config.Client.RunTriggers.Create(ctx, workspaceID, options)
 		if err == nil {
 			d.SetId(runTrigger.ID)
@@ -74,13 +77,13 @@ func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error creating run trigger on workspace %s with sourceable %s: %w", workspaceID, sourceableID, err)
+		return diag.Errorf("Error creating run trigger on workspace %s with sourceable %s: %v", workspaceID, sourceableID, err)
 	}
 
-	return resourceTFERunTriggerRead(d, meta)
+	return resourceTFERunTriggerRead(ctx, d, meta)
 }
 
-func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERunTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read run trigger: %s", d.Id())
@@ -91,7 +94,7 @@ func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading run trigger %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading run trigger %s: %v", d.Id(), err)
 	}
 
 	d.Set("workspace_id", runTrigger.Workspace.ID)
@@ -100,7 +103,7 @@ func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFERunTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERunTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete run trigger: %s", d.Id())
@@ -109,8 +112,50 @@ func resourceTFERunTriggerDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting run trigger %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting run trigger %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
+
+func resourceTFERunTriggerImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(ConfiguredClient)
+
+	// Import formats:
+	//  - <RUN TRIGGER ID>
+	//  - <ORGANIZATION NAME>/<SOURCE WORKSPACE NAME>/<WORKSPACE NAME>
+	s := strings.Split(d.Id(), "/")
+	switch len(s) {
+	case 1:
+		return []*schema.ResourceData{d}, nil
+	case 3:
+	default:
+		return nil, fmt.Errorf(
+			"invalid run trigger import format: %s (expected <RUN TRIGGER ID> or <ORGANIZATION>/<SOURCE WORKSPACE NAME>/<WORKSPACE NAME>)",
+			d.Id(),
+		)
+	}
+
+	org, sourceName, workspaceName := s[0], s[1], s[2]
+	if err := config.useOrganization(org); err != nil {
+		return nil, err
+	}
+	sourceableID, err := fetchWorkspaceExternalID(ctx, org+"/"+sourceName, config.Client)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving workspace %s from organization %s: %w", sourceName, org, err)
+	}
+	workspaceID, err := fetchWorkspaceExternalID(ctx, org+"/"+workspaceName, config.Client)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving workspace %s from organization %s: %w", workspaceName, org, err)
+	}
+	runTrigger, err := fetchRunTriggerBySource(ctx, config.Client, workspaceID, sourceableID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving run trigger of workspace %s from workspace %s: %w", workspaceName, sourceName, err)
+	}
+	d.SetId(runTrigger.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -72,6 +72,12 @@ func TestAccTFERunTriggerImport(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "tfe_run_trigger.foobar",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("tst-terraform-%d/sourceable-test/workspace-test", rInt),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
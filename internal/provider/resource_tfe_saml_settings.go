@@ -32,7 +32,8 @@ const (
 
 // resourceTFESAMLSettings implements the tfe_saml_settings resource type
 type resourceTFESAMLSettings struct {
-	client *tfe.Client
+	client       *tfe.Client
+	capabilities capabilitiesResolver
 }
 
 // modelFromTFEAdminSAMLSettings builds a modelTFESAMLSettings struct from a tfe.AdminSAMLSetting value
@@ -80,6 +81,12 @@ func (r *resourceTFESAMLSettings) Configure(ctx context.Context, req resource.Co
 		)
 	}
 	r.client = client.Client
+	r.capabilities = client.capabilityResolver("")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan
+func (r *resourceTFESAMLSettings) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanForCapabilities(ctx, r.capabilities, "tfe_saml_settings", req, resp)
 }
 
 // Metadata implements resource.Resource
@@ -330,6 +337,7 @@ var (
 	_ resource.Resource                = &resourceTFESAMLSettings{}
 	_ resource.ResourceWithConfigure   = &resourceTFESAMLSettings{}
 	_ resource.ResourceWithImportState = &resourceTFESAMLSettings{}
+	_ resource.ResourceWithModifyPlan  = &resourceTFESAMLSettings{}
 )
 
 // NewSAMLSettingsResource is a resource function for the framework provider.
@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -22,10 +23,11 @@ import (
 func resourceTFESentinelPolicy() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "tfe_sentinel_policy is deprecated, please use tfe_policy instead",
-		Create:             resourceTFESentinelPolicyCreate,
-		Read:               resourceTFESentinelPolicyRead,
-		Update:             resourceTFESentinelPolicyUpdate,
-		Delete:             resourceTFESentinelPolicyDelete,
+		CreateContext:      resourceTFESentinelPolicyCreate,
+		ReadContext:        resourceTFESentinelPolicyRead,
+		UpdateContext:      resourceTFESentinelPolicyUpdate,
+		DeleteContext:      resourceTFESentinelPolicyDelete,
+		Timeouts:           resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFESentinelPolicyImporter,
 		},
@@ -74,14 +76,14 @@ func resourceTFESentinelPolicy() *schema.Resource {
 	}
 }
 
-func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -102,8 +104,8 @@ func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Create sentinel policy %s for organization: %s", name, organization)
 	policy, err := config.Client.Policies.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating sentinel policy %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating sentinel policy %s for organization %s: %v", name, organization, err)
 	}
 
 	d.SetId(policy.ID)
@@ -111,14 +113,14 @@ func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Upload sentinel policy %s for organization: %s", name, organization)
 	err = config.Client.Policies.Upload(ctx, policy.ID, []byte(d.Get("policy").(string)))
 	if err != nil {
-		return fmt.Errorf(
-			"Error uploading sentinel policy %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error uploading sentinel policy %s for organization %s: %v", name, organization, err)
 	}
 
-	return resourceTFESentinelPolicyRead(d, meta)
+	return resourceTFESentinelPolicyRead(ctx, d, meta)
 }
 
-func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read sentinel policy: %s", d.Id())
@@ -129,7 +131,7 @@ func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading sentinel policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading sentinel policy %s: %v", d.Id(), err)
 	}
 
 	// Update the config.
@@ -143,14 +145,14 @@ func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) err
 
 	content, err := config.Client.Policies.Download(ctx, policy.ID)
 	if err != nil {
-		return fmt.Errorf("Error downloading sentinel policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error downloading sentinel policy %s: %v", d.Id(), err)
 	}
 	d.Set("policy", string(content))
 
 	return nil
 }
 
-func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	if d.HasChange("description") || d.HasChange("enforce_mode") {
@@ -174,8 +176,8 @@ func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Update configuration for sentinel policy: %s", d.Id())
 		_, err := config.Client.Policies.Update(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating configuration for sentinel policy %s: %w", d.Id(), err)
+			return diag.Errorf(
+				"Error updating configuration for sentinel policy %s: %v", d.Id(), err)
 		}
 	}
 
@@ -183,14 +185,14 @@ func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Update sentinel policy: %s", d.Id())
 		err := config.Client.Policies.Upload(ctx, d.Id(), []byte(d.Get("policy").(string)))
 		if err != nil {
-			return fmt.Errorf("Error updating sentinel policy %s: %w", d.Id(), err)
+			return diag.Errorf("Error updating sentinel policy %s: %v", d.Id(), err)
 		}
 	}
 
-	return resourceTFESentinelPolicyRead(d, meta)
+	return resourceTFESentinelPolicyRead(ctx, d, meta)
 }
 
-func resourceTFESentinelPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete sentinel policy: %s", d.Id())
@@ -199,7 +201,7 @@ func resourceTFESentinelPolicyDelete(d *schema.ResourceData, meta interface{}) e
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting sentinel policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting sentinel policy %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -209,7 +211,7 @@ func resourceTFESentinelPolicyImporter(ctx context.Context, d *schema.ResourceDa
 	s := strings.SplitN(d.Id(), "/", 2)
 	if len(s) != 2 {
 		return nil, fmt.Errorf(
-			"invalid Sentinel policy import format: %s (expected <ORGANIZATION>/<POLICY ID>)",
+			"invalid Sentinel policy import format: %s (expected <ORGANIZATION>/<POLICY ID> or <ORGANIZATION>/<POLICY NAME>)",
 			d.Id(),
 		)
 	}
@@ -218,5 +220,18 @@ func resourceTFESentinelPolicyImporter(ctx context.Context, d *schema.ResourceDa
 	d.Set("organization", s[0])
 	d.SetId(s[1])
 
+	if !isResourceIDFormat("pol", s[1]) {
+		config := meta.(ConfiguredClient)
+		if err := config.useOrganization(s[0]); err != nil {
+			return nil, err
+		}
+		policy, err := fetchPolicyByName(ctx, config.Client, s[0], s[1])
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving policy with name %s from organization %s: %w", s[1], s[0], err)
+		}
+		d.SetId(policy.ID)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
@@ -11,19 +11,23 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFESentinelVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFESentinelVersionCreate,
-		Read:   resourceTFESentinelVersionRead,
-		Update: resourceTFESentinelVersionUpdate,
-		Delete: resourceTFESentinelVersionDelete,
+		CreateContext: resourceTFESentinelVersionCreate,
+		ReadContext:   resourceTFESentinelVersionRead,
+		UpdateContext: resourceTFESentinelVersionUpdate,
+		DeleteContext: resourceTFESentinelVersionDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFESentinelVersionImporter,
 		},
 
+		CustomizeDiff: customizeDiffCapabilities("tfe_sentinel_version"),
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeString,
@@ -66,7 +70,7 @@ func resourceTFESentinelVersion() *schema.Resource {
 	}
 }
 
-func resourceTFESentinelVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	opts := tfe.AdminSentinelVersionCreateOptions{
@@ -83,15 +87,15 @@ func resourceTFESentinelVersionCreate(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Create new Sentinel version: %s", opts.Version)
 	v, err := config.Client.Admin.SentinelVersions.Create(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("error creating the new Sentinel version %s: %w", opts.Version, err)
+		return diag.Errorf("error creating the new Sentinel version %s: %v", opts.Version, err)
 	}
 
 	d.SetId(v.ID)
 
-	return resourceTFESentinelVersionRead(d, meta)
+	return resourceTFESentinelVersionRead(ctx, d, meta)
 }
 
-func resourceTFESentinelVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of Sentinel version: %s", d.Id())
@@ -102,7 +106,7 @@ func resourceTFESentinelVersionRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("version", v.Version)
@@ -117,7 +121,7 @@ func resourceTFESentinelVersionRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceTFESentinelVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	opts := tfe.AdminSentinelVersionUpdateOptions{
@@ -134,15 +138,15 @@ func resourceTFESentinelVersionUpdate(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Update configuration of Sentinel version: %s", d.Id())
 	v, err := config.Client.Admin.SentinelVersions.Update(ctx, d.Id(), opts)
 	if err != nil {
-		return fmt.Errorf("error updating Sentinel version %s: %w", d.Id(), err)
+		return diag.Errorf("error updating Sentinel version %s: %v", d.Id(), err)
 	}
 
 	d.SetId(v.ID)
 
-	return resourceTFESentinelVersionRead(d, meta)
+	return resourceTFESentinelVersionRead(ctx, d, meta)
 }
 
-func resourceTFESentinelVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete Sentinel version: %s", d.Id())
@@ -152,7 +156,7 @@ func resourceTFESentinelVersionDelete(d *schema.ResourceData, meta interface{})
 			log.Printf("[DEBUG] Sentinel version: %s not found", d.Id())
 			return nil
 		}
-		return fmt.Errorf("error deleting Sentinel version %s: %w", d.Id(), err)
+		return diag.Errorf("error deleting Sentinel version %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -165,7 +169,7 @@ func resourceTFESentinelVersionImporter(ctx context.Context, d *schema.ResourceD
 	// determines if the string is a tool version ID
 	s := strings.Split(d.Id(), "-")
 	if s[0] != "tool" {
-		versionID, err := fetchSentinelVersionID(d.Id(), config.Client)
+		versionID, err := fetchSentinelVersionID(ctx, d.Id(), config.Client)
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving sentinel version %s: %w", d.Id(), err)
 		}
@@ -9,19 +9,21 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFESSHKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFESSHKeyCreate,
-		Read:   resourceTFESSHKeyRead,
-		Update: resourceTFESSHKeyUpdate,
-		Delete: resourceTFESSHKeyDelete,
+		CreateContext: resourceTFESSHKeyCreate,
+		ReadContext:   resourceTFESSHKeyRead,
+		UpdateContext: resourceTFESSHKeyUpdate,
+		DeleteContext: resourceTFESSHKeyDelete,
+		Timeouts:      resourceTimeouts(true),
 
 		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
 
@@ -47,14 +49,14 @@ func resourceTFESSHKey() *schema.Resource {
 	}
 }
 
-func resourceTFESSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -66,16 +68,16 @@ func resourceTFESSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Create new SSH key for organization: %s", organization)
 	sshKey, err := config.Client.SSHKeys.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating SSH key %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating SSH key %s for organization %s: %v", name, organization, err)
 	}
 
 	d.SetId(sshKey.ID)
 
-	return resourceTFESSHKeyUpdate(d, meta)
+	return resourceTFESSHKeyUpdate(ctx, d, meta)
 }
 
-func resourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of SSH key: %s", d.Id())
@@ -86,7 +88,7 @@ func resourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of SSH key %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of SSH key %s: %v", d.Id(), err)
 	}
 
 	// Update the config.
@@ -95,7 +97,7 @@ func resourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFESSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Create a new options struct.
@@ -106,13 +108,13 @@ func resourceTFESSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Update SSH key: %s", d.Id())
 	_, err := config.Client.SSHKeys.Update(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error updating SSH key %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating SSH key %s: %v", d.Id(), err)
 	}
 
-	return resourceTFESSHKeyRead(d, meta)
+	return resourceTFESSHKeyRead(ctx, d, meta)
 }
 
-func resourceTFESSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete SSH key: %s", d.Id())
@@ -121,7 +123,7 @@ func resourceTFESSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting SSH key %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting SSH key %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -23,6 +23,7 @@ import (
 var _ resource.Resource = &resourceTFEStack{}
 var _ resource.ResourceWithConfigure = &resourceTFEStack{}
 var _ resource.ResourceWithImportState = &resourceTFEStack{}
+var _ resource.ResourceWithModifyPlan = &resourceTFEStack{}
 
 func NewStackResource() resource.Resource {
 	return &resourceTFEStack{}
@@ -30,7 +31,8 @@ func NewStackResource() resource.Resource {
 
 // resourceTFEStack implements the tfe_stack resource type
 type resourceTFEStack struct {
-	config ConfiguredClient
+	config       ConfiguredClient
+	capabilities capabilitiesResolver
 }
 
 func (r *resourceTFEStack) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -139,9 +141,19 @@ func (r *resourceTFEStack) Configure(ctx context.Context, req resource.Configure
 		)
 	}
 	r.config = client
+	r.capabilities = client.capabilityResolver(client.Organization)
+}
+
+func (r *resourceTFEStack) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanForCapabilities(ctx, r.capabilities, "tfe_stack", req, resp)
 }
 
 func (r *resourceTFEStack) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "project_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan modelTFEStack
 
 	// Read Terraform plan data into the model
@@ -186,6 +198,11 @@ func (r *resourceTFEStack) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *resourceTFEStack) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "project_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state modelTFEStack
 
 	// Read Terraform prior state data into the model
@@ -209,6 +226,11 @@ func (r *resourceTFEStack) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *resourceTFEStack) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "project_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan modelTFEStack
 	var state modelTFEStack
 
@@ -247,6 +269,11 @@ func (r *resourceTFEStack) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *resourceTFEStack) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "project_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state modelTFEStack
 
 	// Read Terraform prior state data into the model
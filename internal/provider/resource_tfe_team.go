@@ -19,15 +19,17 @@ import (
 	"errors"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFETeam() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamCreate,
-		Read:   resourceTFETeamRead,
-		Update: resourceTFETeamUpdate,
-		Delete: resourceTFETeamDelete,
+		CreateContext: resourceTFETeamCreate,
+		ReadContext:   resourceTFETeamRead,
+		UpdateContext: resourceTFETeamUpdate,
+		DeleteContext: resourceTFETeamDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamImporter,
 		},
@@ -150,14 +152,14 @@ func resourceTFETeam() *schema.Resource {
 	}
 }
 
-func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get team attributes.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -203,21 +205,21 @@ func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
 		if errors.Is(err, tfe.ErrResourceNotFound) {
 			entitlements, _ := config.Client.Organizations.ReadEntitlements(ctx, organization)
 			if entitlements == nil {
-				return fmt.Errorf("Error creating team %s for organization %s: %w", name, organization, err)
+				return diag.Errorf("Error creating team %s for organization %s: %v", name, organization, err)
 			}
 			if !entitlements.Teams {
-				return fmt.Errorf("Error creating team %s for organization %s: missing entitlements to create teams", name, organization)
+				return diag.Errorf("Error creating team %s for organization %s: missing entitlements to create teams", name, organization)
 			}
 		}
-		return fmt.Errorf("Error creating team %s for organization %s: %w", name, organization, err)
+		return diag.Errorf("Error creating team %s for organization %s: %v", name, organization, err)
 	}
 
 	d.SetId(team.ID)
 
-	return resourceTFETeamRead(d, meta)
+	return resourceTFETeamRead(ctx, d, meta)
 }
 
-func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of team: %s", d.Id())
@@ -228,7 +230,7 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of team %s: %v", d.Id(), err)
 	}
 
 	// Update the config.
@@ -252,7 +254,7 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 			"manage_agent_pools":         team.OrganizationAccess.ManageAgentPools,
 		}}
 		if err := d.Set("organization_access", organizationAccess); err != nil {
-			return fmt.Errorf("error setting organization access for team %s: %w", d.Id(), err)
+			return diag.Errorf("error setting organization access for team %s: %v", d.Id(), err)
 		}
 	}
 	d.Set("visibility", team.Visibility)
@@ -262,7 +264,7 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFETeamUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
@@ -310,14 +312,14 @@ func resourceTFETeamUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Update team: %s", d.Id())
 	_, err := config.Client.Teams.Update(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error updating team %s: %w", d.Id(), err)
+		return diag.Errorf(
+			"Error updating team %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceTFETeamDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete team: %s", d.Id())
@@ -326,7 +328,7 @@ func resourceTFETeamDelete(d *schema.ResourceData, meta interface{}) error {
 		if errors.Is(err, tfe.ErrResourceNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting team %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting team %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -15,16 +15,18 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFETeamAccess() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamAccessCreate,
-		Read:   resourceTFETeamAccessRead,
-		Update: resourceTFETeamAccessUpdate,
-		Delete: resourceTFETeamAccessDelete,
+		CreateContext: resourceTFETeamAccessCreate,
+		ReadContext:   resourceTFETeamAccessRead,
+		UpdateContext: resourceTFETeamAccessUpdate,
+		DeleteContext: resourceTFETeamAccessDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamAccessImporter,
 		},
@@ -148,7 +150,7 @@ func resourceTFETeamAccess() *schema.Resource {
 	}
 }
 
-func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the access level
@@ -158,15 +160,15 @@ func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error
 	workspaceID := d.Get("workspace_id").(string)
 	ws, err := config.Client.Workspaces.ReadByID(ctx, workspaceID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error retrieving workspace %s: %w", workspaceID, err)
+		return diag.Errorf(
+			"Error retrieving workspace %s: %v", workspaceID, err)
 	}
 
 	// Get the team.
 	teamID := d.Get("team_id").(string)
 	tm, err := config.Client.Teams.Read(ctx, teamID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving team %s: %w", teamID, err)
+		return diag.Errorf("Error retrieving team %s: %v", teamID, err)
 	}
 
 	// Create a new options struct.
@@ -215,16 +217,16 @@ func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Give team %s %s access to workspace: %s", tm.Name, access, ws.Name)
 	tmAccess, err := config.Client.TeamAccess.Add(ctx, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error giving team %s %s access to workspace %s: %w", tm.Name, access, ws.Name, err)
+		return diag.Errorf(
+			"Error giving team %s %s access to workspace %s: %v", tm.Name, access, ws.Name, err)
 	}
 
 	d.SetId(tmAccess.ID)
 
-	return resourceTFETeamAccessRead(d, meta)
+	return resourceTFETeamAccessRead(ctx, d, meta)
 }
 
-func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of team access: %s", d.Id())
@@ -235,7 +237,7 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of team access %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of team access %s: %v", d.Id(), err)
 	}
 
 	// Update config.
@@ -249,7 +251,7 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 		"run_tasks":         tmAccess.RunTasks,
 	}}
 	if err := d.Set("permissions", permissions); err != nil {
-		return fmt.Errorf("error setting permissions for team access %s: %w", d.Id(), err)
+		return diag.Errorf("error setting permissions for team access %s: %v", d.Id(), err)
 	}
 
 	if tmAccess.Team != nil {
@@ -261,7 +263,7 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// create an options struct
@@ -310,8 +312,8 @@ func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Update team access: %s", d.Id())
 	tmAccess, err := config.Client.TeamAccess.Update(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error updating team access %s: %w", d.Id(), err)
+		return diag.Errorf(
+			"Error updating team access %s: %v", d.Id(), err)
 	}
 
 	// Update permissions, in the case that they were marked to be recomputed.
@@ -324,13 +326,13 @@ func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error
 		"run_tasks":         tmAccess.RunTasks,
 	}}
 	if err := d.Set("permissions", permissions); err != nil {
-		return fmt.Errorf("error setting permissions for team access %s: %w", d.Id(), err)
+		return diag.Errorf("error setting permissions for team access %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceTFETeamAccessDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete team access: %s", d.Id())
@@ -339,7 +341,7 @@ func resourceTFETeamAccessDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting team access %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting team access %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -351,13 +353,17 @@ func resourceTFETeamAccessImporter(ctx context.Context, d *schema.ResourceData,
 	s := strings.SplitN(d.Id(), "/", 3)
 	if len(s) != 3 {
 		return nil, fmt.Errorf(
-			"invalid team access import format: %s (expected <ORGANIZATION>/<WORKSPACE>/<TEAM ACCESS ID>)",
+			"invalid team access import format: %s (expected <ORGANIZATION>/<WORKSPACE>/<TEAM ACCESS ID> or <ORGANIZATION>/<WORKSPACE>/<TEAM NAME>)",
 			d.Id(),
 		)
 	}
 
+	if err := config.useOrganization(s[0]); err != nil {
+		return nil, err
+	}
+
 	// Set the fields that are part of the import ID.
-	workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], config.Client)
+	workspaceID, err := fetchWorkspaceExternalID(ctx, s[0]+"/"+s[1], config.Client)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error retrieving workspace %s from organization %s: %w", s[1], s[0], err)
@@ -365,6 +371,20 @@ func resourceTFETeamAccessImporter(ctx context.Context, d *schema.ResourceData,
 	d.Set("workspace_id", workspaceID)
 	d.SetId(s[2])
 
+	if !isResourceIDFormat("tws", s[2]) {
+		team, err := fetchTeamByName(ctx, config.Client, s[0], s[2])
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving team with name %s from organization %s: %w", s[2], s[0], err)
+		}
+		access, err := fetchTeamAccessByTeam(ctx, config.Client, workspaceID, team)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving access of team %s to workspace %s: %w", s[2], s[1], err)
+		}
+		d.SetId(access.ID)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
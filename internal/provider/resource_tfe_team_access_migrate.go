@@ -45,11 +45,11 @@ func resourceTfeTeamAccessResourceV0() *schema.Resource {
 	}
 }
 
-func resourceTfeTeamAccessStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+func resourceTfeTeamAccessStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	config := meta.(ConfiguredClient)
 
 	humanID := rawState["workspace_id"].(string)
-	id, err := fetchWorkspaceExternalID(humanID, config.Client)
+	id, err := fetchWorkspaceExternalID(ctx, humanID, config.Client)
 	if err != nil {
 		return nil, fmt.Errorf("Error reading configuration of workspace %s: %w", humanID, err)
 	}
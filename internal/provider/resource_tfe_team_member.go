@@ -9,21 +9,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFETeamMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamMemberCreate,
-		Read:   resourceTFETeamMemberRead,
-		Delete: resourceTFETeamMemberDelete,
+		CreateContext: resourceTFETeamMemberCreate,
+		ReadContext:   resourceTFETeamMemberRead,
+		DeleteContext: resourceTFETeamMemberDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTFETeamMemberImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,7 +45,7 @@ func resourceTFETeamMember() *schema.Resource {
 	}
 }
 
-func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and username..
@@ -57,7 +60,7 @@ func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Add user %q to team: %s", username, teamID)
 	err := config.Client.TeamMembers.Add(ctx, teamID, options)
 	if err != nil {
-		return fmt.Errorf("Error adding user %q to team %s: %w", username, teamID, err)
+		return diag.Errorf("Error adding user %q to team %s: %v", username, teamID, err)
 	}
 
 	d.SetId(packTeamMemberID(teamID, username))
@@ -65,13 +68,13 @@ func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and username.
 	teamID, username, err := unpackTeamMemberID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error unpacking team member ID: %w", err)
+		return diag.Errorf("Error unpacking team member ID: %v", err)
 	}
 
 	log.Printf("[DEBUG] Read users from team: %s", teamID)
@@ -82,7 +85,7 @@ func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading users from team %s: %w", teamID, err)
+		return diag.Errorf("Error reading users from team %s: %v", teamID, err)
 	}
 
 	found := false
@@ -107,13 +110,13 @@ func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFETeamMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and username.
 	teamID, username, err := unpackTeamMemberID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error unpacking team member ID: %w", err)
+		return diag.Errorf("Error unpacking team member ID: %v", err)
 	}
 
 	// Create a new options struct.
@@ -124,7 +127,7 @@ func resourceTFETeamMemberDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Remove user %q from team: %s", username, teamID)
 	err = config.Client.TeamMembers.Remove(ctx, teamID, options)
 	if err != nil {
-		return fmt.Errorf("Error removing user %q to team %s: %w", username, teamID, err)
+		return diag.Errorf("Error removing user %q to team %s: %v", username, teamID, err)
 	}
 
 	return nil
@@ -148,3 +151,29 @@ func unpackTeamMemberID(id string) (teamID, username string, err error) {
 
 	return s[0], s[1], nil
 }
+
+func resourceTFETeamMemberImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(ConfiguredClient)
+
+	// Import formats:
+	//  - <TEAM ID>/<USERNAME>
+	//  - <ORGANIZATION NAME>/<TEAM NAME>/<USERNAME>
+	s := strings.SplitN(d.Id(), "/", 3)
+	if len(s) != 3 {
+		// the <TEAM ID>/<USERNAME> is the default ID, so pass it on through
+		return []*schema.ResourceData{d}, nil
+	}
+
+	org, teamName, username := s[0], s[1], s[2]
+	if err := config.useOrganization(org); err != nil {
+		return nil, err
+	}
+	team, err := fetchTeamByName(ctx, config.Client, org, teamName)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving team with name %s from organization %s: %w", teamName, org, err)
+	}
+	d.SetId(packTeamMemberID(team.ID, username))
+
+	return []*schema.ResourceData{d}, nil
+}
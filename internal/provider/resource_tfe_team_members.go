@@ -10,19 +10,20 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFETeamMembers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamMembersCreate,
-		Read:   resourceTFETeamMembersRead,
-		Update: resourceTFETeamMembersUpdate,
-		Delete: resourceTFETeamMembersDelete,
+		CreateContext: resourceTFETeamMembersCreate,
+		ReadContext:   resourceTFETeamMembersRead,
+		UpdateContext: resourceTFETeamMembersUpdate,
+		DeleteContext: resourceTFETeamMembersDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamMembersImporter,
 		},
@@ -43,7 +44,7 @@ func resourceTFETeamMembers() *schema.Resource {
 	}
 }
 
-func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID.
@@ -60,7 +61,7 @@ func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Add users to team: %s", teamID)
 	err := config.Client.TeamMembers.Add(ctx, teamID, options)
 	if err != nil {
-		return fmt.Errorf("Error adding users to team %s: %w", teamID, err)
+		return diag.Errorf("Error adding users to team %s: %v", teamID, err)
 	}
 
 	d.SetId(teamID)
@@ -68,7 +69,7 @@ func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read users from team: %s", d.Id())
@@ -79,7 +80,7 @@ func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading users from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading users from team %s: %v", d.Id(), err)
 	}
 
 	var usernames []interface{}
@@ -97,7 +98,7 @@ func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	if d.HasChange("usernames") {
@@ -118,7 +119,7 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[DEBUG] Add users to team: %s", d.Id())
 			err := config.Client.TeamMembers.Add(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error adding users to team %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding users to team %s: %v", d.Id(), err)
 			}
 		}
 
@@ -135,7 +136,7 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[DEBUG] Remove users from team: %s", d.Id())
 			err := config.Client.TeamMembers.Remove(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error removing users to team %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing users to team %s: %v", d.Id(), err)
 			}
 		}
 	}
@@ -143,7 +144,7 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceTFETeamMembersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Retrieve users to remove from team: %s", d.Id())
@@ -152,7 +153,7 @@ func resourceTFETeamMembersDelete(d *schema.ResourceData, meta interface{}) erro
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error retrieving users to remove from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error retrieving users to remove from team %s: %v", d.Id(), err)
 	}
 
 	// Create a new options struct.
@@ -166,13 +167,20 @@ func resourceTFETeamMembersDelete(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Remove users from team: %s", d.Id())
 	err = config.Client.TeamMembers.Remove(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error removing users to team %s: %w", d.Id(), err)
+		return diag.Errorf("Error removing users to team %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
 func resourceTFETeamMembersImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Import formats:
+	//  - <TEAM ID>
+	//  - <ORGANIZATION NAME>/<TEAM NAME>
+	if _, err := importByIDOrName("team", fetchTeamID)(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	// Set the team ID field.
 	d.Set("team_id", d.Id())
 
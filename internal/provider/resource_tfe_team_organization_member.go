@@ -15,14 +15,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFETeamOrganizationMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamOrganizationMemberCreate,
-		Read:   resourceTFETeamOrganizationMemberRead,
-		Delete: resourceTFETeamOrganizationMemberDelete,
+		CreateContext: resourceTFETeamOrganizationMemberCreate,
+		ReadContext:   resourceTFETeamOrganizationMemberRead,
+		DeleteContext: resourceTFETeamOrganizationMemberDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamOrganizationMemberImporter,
 		},
@@ -43,7 +45,7 @@ func resourceTFETeamOrganizationMember() *schema.Resource {
 	}
 }
 
-func resourceTFETeamOrganizationMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and username..
@@ -58,7 +60,7 @@ func resourceTFETeamOrganizationMemberCreate(d *schema.ResourceData, meta interf
 	log.Printf("[DEBUG] Add organization membership %q to team: %s", organizationMembershipID, teamID)
 	err := config.Client.TeamMembers.Add(ctx, teamID, options)
 	if err != nil {
-		return fmt.Errorf("Error adding organization membership %q to team %s: %w", organizationMembershipID, teamID, err)
+		return diag.Errorf("Error adding organization membership %q to team %s: %v", organizationMembershipID, teamID, err)
 	}
 
 	d.SetId(packTeamOrganizationMemberID(teamID, organizationMembershipID))
@@ -66,13 +68,13 @@ func resourceTFETeamOrganizationMemberCreate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTFETeamOrganizationMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and organization membership id.
 	teamID, organizationMembershipID, err := unpackTeamOrganizationMemberID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error unpacking team member ID: %w", err)
+		return diag.Errorf("Error unpacking team member ID: %v", err)
 	}
 
 	log.Printf("[DEBUG] Read organization membership from team: %s", teamID)
@@ -83,7 +85,7 @@ func resourceTFETeamOrganizationMemberRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading organization memberships from team %s: %w", teamID, err)
+		return diag.Errorf("Error reading organization memberships from team %s: %v", teamID, err)
 	}
 
 	found := false
@@ -105,13 +107,13 @@ func resourceTFETeamOrganizationMemberRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTFETeamOrganizationMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and organization membership id.
 	teamID, organizationMembershipID, err := unpackTeamOrganizationMemberID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error unpacking team member ID: %w", err)
+		return diag.Errorf("Error unpacking team member ID: %v", err)
 	}
 
 	// Create a new options struct.
@@ -122,7 +124,7 @@ func resourceTFETeamOrganizationMemberDelete(d *schema.ResourceData, meta interf
 	log.Printf("[DEBUG] Remove organization membership %q from team: %s", organizationMembershipID, teamID)
 	err = config.Client.TeamMembers.Remove(ctx, teamID, options)
 	if err != nil {
-		return fmt.Errorf("Error removing organization membership %q to team %s: %w", organizationMembershipID, teamID, err)
+		return diag.Errorf("Error removing organization membership %q to team %s: %v", organizationMembershipID, teamID, err)
 	}
 
 	return nil
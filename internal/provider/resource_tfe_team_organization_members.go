@@ -9,22 +9,25 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFETeamOrganizationMembers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamOrganizationMembersCreate,
-		Read:   resourceTFETeamOrganizationMembersRead,
-		Update: resourceTFETeamOrganizationMembersUpdate,
-		Delete: resourceTFETeamOrganizationMembersDelete,
+		CreateContext: resourceTFETeamOrganizationMembersCreate,
+		ReadContext:   resourceTFETeamOrganizationMembersRead,
+		UpdateContext: resourceTFETeamOrganizationMembersUpdate,
+		DeleteContext: resourceTFETeamOrganizationMembersDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByIDOrName("team", fetchTeamID),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,7 +46,7 @@ func resourceTFETeamOrganizationMembers() *schema.Resource {
 	}
 }
 
-func resourceTFETeamOrganizationMembersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID.
@@ -63,7 +66,7 @@ func resourceTFETeamOrganizationMembersCreate(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Add organization memberships %v to team: %s", organizationMembershipIDs, teamID)
 	err := config.Client.TeamMembers.Add(ctx, teamID, options)
 	if err != nil {
-		return fmt.Errorf("Error adding organization memberships %v to team %s: %w", organizationMembershipIDs, teamID, err)
+		return diag.Errorf("Error adding organization memberships %v to team %s: %v", organizationMembershipIDs, teamID, err)
 	}
 
 	d.SetId(teamID)
@@ -71,7 +74,7 @@ func resourceTFETeamOrganizationMembersCreate(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFETeamOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read organization memberships from team: %s", d.Id())
@@ -82,7 +85,7 @@ func resourceTFETeamOrganizationMembersRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading organization memberships from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading organization memberships from team %s: %v", d.Id(), err)
 	}
 
 	// Get all organization memberships and add them to object
@@ -103,7 +106,7 @@ func resourceTFETeamOrganizationMembersRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func fetchExistingTeamMembershipIds(config *tfe.Client, teamID string) (map[string]interface{}, error) {
+func fetchExistingTeamMembershipIds(ctx context.Context, config *tfe.Client, teamID string) (map[string]interface{}, error) {
 	teamMembers, err := config.TeamMembers.ListOrganizationMemberships(ctx, teamID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch existing organization memberships for team %s: %w", teamID, err)
@@ -117,7 +120,7 @@ func fetchExistingTeamMembershipIds(config *tfe.Client, teamID string) (map[stri
 	return teamMembersIDSet, nil
 }
 
-func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var membershipIDsToDelete *schema.Set
@@ -142,7 +145,7 @@ func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Add organization memberships %v to team: %s", options.OrganizationMembershipIDs, d.Id())
 		err := config.Client.TeamMembers.Add(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf("Error adding organization memberships to team %s: %w", d.Id(), err)
+			return diag.Errorf("Error adding organization memberships to team %s: %v", d.Id(), err)
 		}
 	}
 
@@ -151,9 +154,9 @@ func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta inter
 	}
 
 	// Then delete all the old users.
-	existingIDs, err := fetchExistingTeamMembershipIds(config.Client, d.Id())
+	existingIDs, err := fetchExistingTeamMembershipIds(ctx, config.Client, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -172,7 +175,7 @@ func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Remove organization memberships %v from team: %s", options.OrganizationMembershipIDs, d.Id())
 		err = config.Client.TeamMembers.Remove(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf("Error removing organization memberships from team %s: %w", d.Id(), err)
+			return diag.Errorf("Error removing organization memberships from team %s: %v", d.Id(), err)
 		}
 	} else {
 		log.Printf("[DEBUG] All members planned to be removed from this team were already removed from team %s", d.Id())
@@ -181,7 +184,7 @@ func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read organization memberships from team: %s", d.Id())
@@ -192,7 +195,7 @@ func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading organization memberships from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading organization memberships from team %s: %v", d.Id(), err)
 	}
 
 	// Create a new options struct.
@@ -206,7 +209,7 @@ func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Remove organization memberships %v from team: %s", options.OrganizationMembershipIDs, d.Id())
 	err = config.Client.TeamMembers.Remove(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error removing organization membership %v to team %s: %w", options.OrganizationMembershipIDs, d.Id(), err)
+		return diag.Errorf("Error removing organization membership %v to team %s: %v", options.OrganizationMembershipIDs, d.Id(), err)
 	}
 
 	return nil
@@ -13,9 +13,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -26,13 +28,17 @@ func resourceTFETeamProjectAccess() *schema.Resource {
 		ReadContext:   resourceTFETeamProjectAccessRead,
 		UpdateContext: resourceTFETeamProjectAccessUpdate,
 		DeleteContext: resourceTFETeamProjectAccessDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTFETeamProjectAccessImporter,
 		},
 
 		SchemaVersion: 1,
 
-		CustomizeDiff: checkForCustomPermissions,
+		CustomizeDiff: customdiff.All(
+			checkForCustomPermissions,
+			customizeDiffCapabilities("tfe_team_project_access"),
+		),
 		Schema: map[string]*schema.Schema{
 			"access": {
 				Type:     schema.TypeString,
@@ -473,3 +479,45 @@ func checkForCustomPermissions(_ context.Context, d *schema.ResourceDiff, meta i
 
 	return nil
 }
+
+func resourceTFETeamProjectAccessImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(ConfiguredClient)
+
+	// Import formats:
+	//  - <TEAM PROJECT ACCESS ID>
+	//  - <ORGANIZATION NAME>/<PROJECT NAME>/<TEAM NAME>
+	s := strings.Split(d.Id(), "/")
+	switch len(s) {
+	case 1:
+		return []*schema.ResourceData{d}, nil
+	case 3:
+	default:
+		return nil, fmt.Errorf(
+			"invalid team project access import format: %s (expected <TEAM PROJECT ACCESS ID> or <ORGANIZATION>/<PROJECT NAME>/<TEAM NAME>)",
+			d.Id(),
+		)
+	}
+
+	org, projectName, teamName := s[0], s[1], s[2]
+	if err := config.useOrganization(org); err != nil {
+		return nil, err
+	}
+	project, err := fetchProjectByName(ctx, config.Client, org, projectName)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving project with name %s from organization %s: %w", projectName, org, err)
+	}
+	team, err := fetchTeamByName(ctx, config.Client, org, teamName)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving team with name %s from organization %s: %w", teamName, org, err)
+	}
+	access, err := fetchTeamProjectAccessByTeam(ctx, config.Client, project.ID, team)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving access of team %s to project %s: %w", teamName, projectName, err)
+	}
+	d.SetId(access.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -88,6 +88,12 @@ func TestAccTFETeamProjectAccess_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "tfe_team_project_access.foobar",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("tst-terraform-%d/projecttest/team-test", rInt),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -11,19 +11,20 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFETeamToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamTokenCreate,
-		Read:   resourceTFETeamTokenRead,
-		Delete: resourceTFETeamTokenDelete,
+		CreateContext: resourceTFETeamTokenCreate,
+		ReadContext:   resourceTFETeamTokenRead,
+		DeleteContext: resourceTFETeamTokenDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamTokenImporter,
 		},
@@ -56,7 +57,7 @@ func resourceTFETeamToken() *schema.Resource {
 	}
 }
 
-func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID.
@@ -65,13 +66,13 @@ func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Check if a token already exists for team: %s", teamID)
 	_, err := config.Client.TeamTokens.Read(ctx, teamID)
 	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-		return fmt.Errorf("error checking if a token exists for team %s: %w", teamID, err)
+		return diag.Errorf("error checking if a token exists for team %s: %v", teamID, err)
 	}
 
 	// If error is nil, the token already exists.
 	if err == nil {
 		if !d.Get("force_regenerate").(bool) {
-			return fmt.Errorf("a token already exists for team: %s", teamID)
+			return diag.Errorf("a token already exists for team: %s", teamID)
 		}
 		log.Printf("[DEBUG] Regenerating existing token for team: %s", teamID)
 	}
@@ -89,15 +90,15 @@ func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error
 		options.ExpiredAt = &expiry
 
 		if err != nil {
-			return fmt.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt)
+			return diag.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt)
 		}
 	}
 
 	log.Printf("[DEBUG] Create new token for team: %s", teamID)
 	token, err := config.Client.TeamTokens.CreateWithOptions(ctx, teamID, options)
 	if err != nil {
-		return fmt.Errorf(
-			"error creating new token for team %s: %w", teamID, err)
+		return diag.Errorf(
+			"error creating new token for team %s: %v", teamID, err)
 	}
 
 	d.SetId(teamID)
@@ -106,10 +107,10 @@ func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error
 	// only be returned once during the creation of the token.
 	d.Set("token", token.Token)
 
-	return resourceTFETeamTokenRead(d, meta)
+	return resourceTFETeamTokenRead(ctx, d, meta)
 }
 
-func resourceTFETeamTokenRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read the token from team: %s", d.Id())
@@ -120,13 +121,13 @@ func resourceTFETeamTokenRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading token from team %s: %w", d.Id(), err)
+		return diag.Errorf("error reading token from team %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceTFETeamTokenDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete token from team: %s", d.Id())
@@ -135,13 +136,20 @@ func resourceTFETeamTokenDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("error deleting token from team %s: %w", d.Id(), err)
+		return diag.Errorf("error deleting token from team %s: %v", d.Id(), err)
 	}
 
 	return nil
 }
 
 func resourceTFETeamTokenImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Import formats:
+	//  - <TEAM ID>
+	//  - <ORGANIZATION NAME>/<TEAM NAME>
+	if _, err := importByIDOrName("team", fetchTeamID)(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	// Set the team ID field.
 	d.Set("team_id", d.Id())
 
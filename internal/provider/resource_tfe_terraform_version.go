@@ -15,19 +15,23 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFETerraformVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETerraformVersionCreate,
-		Read:   resourceTFETerraformVersionRead,
-		Update: resourceTFETerraformVersionUpdate,
-		Delete: resourceTFETerraformVersionDelete,
+		CreateContext: resourceTFETerraformVersionCreate,
+		ReadContext:   resourceTFETerraformVersionRead,
+		UpdateContext: resourceTFETerraformVersionUpdate,
+		DeleteContext: resourceTFETerraformVersionDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETerraformVersionImporter,
 		},
 
+		CustomizeDiff: customizeDiffCapabilities("tfe_terraform_version"),
+
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:     schema.TypeString,
@@ -70,7 +74,7 @@ func resourceTFETerraformVersion() *schema.Resource {
 	}
 }
 
-func resourceTFETerraformVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETerraformVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	opts := tfe.AdminTerraformVersionCreateOptions{
@@ -87,15 +91,15 @@ func resourceTFETerraformVersionCreate(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Create new Terraform version: %s", *opts.Version)
 	v, err := config.Client.Admin.TerraformVersions.Create(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("Error creating the new Terraform version %s: %w", *opts.Version, err)
+		return diag.Errorf("Error creating the new Terraform version %s: %v", *opts.Version, err)
 	}
 
 	d.SetId(v.ID)
 
-	return resourceTFETerraformVersionUpdate(d, meta)
+	return resourceTFETerraformVersionUpdate(ctx, d, meta)
 }
 
-func resourceTFETerraformVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETerraformVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of Terraform version: %s", d.Id())
@@ -106,7 +110,7 @@ func resourceTFETerraformVersionRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("version", v.Version)
@@ -121,7 +125,7 @@ func resourceTFETerraformVersionRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTFETerraformVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETerraformVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	opts := tfe.AdminTerraformVersionUpdateOptions{
@@ -138,15 +142,15 @@ func resourceTFETerraformVersionUpdate(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Update configuration of Terraform version: %s", d.Id())
 	v, err := config.Client.Admin.TerraformVersions.Update(ctx, d.Id(), opts)
 	if err != nil {
-		return fmt.Errorf("Error updating Terraform version %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating Terraform version %s: %v", d.Id(), err)
 	}
 
 	d.SetId(v.ID)
 
-	return resourceTFETerraformVersionRead(d, meta)
+	return resourceTFETerraformVersionRead(ctx, d, meta)
 }
 
-func resourceTFETerraformVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETerraformVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete Terraform version: %s", d.Id())
@@ -155,7 +159,7 @@ func resourceTFETerraformVersionDelete(d *schema.ResourceData, meta interface{})
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Terraform version %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting Terraform version %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -168,7 +172,7 @@ func resourceTFETerraformVersionImporter(ctx context.Context, d *schema.Resource
 	// determines if the string is a tool version ID
 	s := strings.Split(d.Id(), "-")
 	if s[0] != "tool" {
-		versionID, err := fetchTerraformVersionID(d.Id(), config.Client)
+		versionID, err := fetchTerraformVersionID(ctx, d.Id(), config.Client)
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving terraform version %s: %w", d.Id(), err)
 		}
@@ -28,6 +28,8 @@ type resourceTFETestVariable struct {
 	config ConfiguredClient
 }
 
+var _ resource.ResourceWithValidateConfig = &resourceTFETestVariable{}
+
 func NewTestVariableResource() resource.Resource {
 	return &resourceTFETestVariable{}
 }
@@ -37,6 +39,8 @@ type modelTFETestVariable struct {
 	ID             types.String `tfsdk:"id"`
 	Key            types.String `tfsdk:"key"`
 	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
 	ReadableValue  types.String `tfsdk:"readable_value"`
 	Category       types.String `tfsdk:"category"`
 	Description    types.String `tfsdk:"description"`
@@ -134,6 +138,8 @@ func (r *resourceTFETestVariable) Schema(ctx context.Context, req resource.Schem
 				Sensitive:   true,
 				Description: "Value of the variable",
 			},
+			"value_wo":         valueWOAttribute(),
+			"value_wo_version": valueWOVersionAttribute(),
 			"category": schema.StringAttribute{
 				Required:    true,
 				Description: `Whether this is a Terraform or environment variable. Valid values are "terraform" or "env".`,
@@ -205,6 +211,17 @@ func (r *resourceTFETestVariable) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valueWO, diags := configValueWO(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	key := data.Key.ValueString()
 	category := data.Category.ValueString()
 	moduleID := tfe.RegistryModuleID{
@@ -223,6 +240,9 @@ func (r *resourceTFETestVariable) Create(ctx context.Context, req resource.Creat
 		Sensitive:   data.Sensitive.ValueBoolPointer(),
 		Description: data.Description.ValueStringPointer(),
 	}
+	if !valueWO.IsNull() {
+		options.Value = valueWO.ValueStringPointer()
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Create %s variable: %s", category, key))
 	variable, err := r.config.Client.TestVariables.Create(ctx, moduleID, options)
@@ -236,6 +256,7 @@ func (r *resourceTFETestVariable) Create(ctx context.Context, req resource.Creat
 
 	// We got a variable, so set state to new values
 	result := modelFromTFETestVariable(*variable, data.Value, moduleID)
+	result.ValueWOVersion = data.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
@@ -249,6 +270,11 @@ func (r *resourceTFETestVariable) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	moduleID := tfe.RegistryModuleID{
 		Organization: data.Organization.ValueString(),
 		Name:         data.ModuleName.ValueString(),
@@ -275,6 +301,7 @@ func (r *resourceTFETestVariable) Read(ctx context.Context, req resource.ReadReq
 
 	// We got a variable, so update state:
 	result := modelFromTFETestVariable(*variable, data.Value, moduleID)
+	result.ValueWOVersion = data.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
@@ -292,6 +319,15 @@ func (r *resourceTFETestVariable) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	valueWO, diags := configValueWO(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	variableID := plan.ID.ValueString()
 	moduleID := tfe.RegistryModuleID{
@@ -310,9 +346,7 @@ func (r *resourceTFETestVariable) Update(ctx context.Context, req resource.Updat
 	}
 	// We ONLY want to set Value if our planned value would be a CHANGE from the
 	// prior state. See comments in updateWithWorkspace for more color.
-	if state.Value.ValueString() != plan.Value.ValueString() {
-		options.Value = plan.Value.ValueStringPointer()
-	}
+	options.Value = updatedVariableValue(plan.Value, state.Value, valueWO, plan.ValueWOVersion, state.ValueWOVersion)
 
 	tflog.Debug(ctx, fmt.Sprintf("Update variable: %s", variableID))
 	variable, err := r.config.Client.TestVariables.Update(ctx, moduleID, variableID, options)
@@ -325,10 +359,15 @@ func (r *resourceTFETestVariable) Update(ctx context.Context, req resource.Updat
 	}
 	// Update state
 	result := modelFromTFETestVariable(*variable, plan.Value, moduleID)
+	result.ValueWOVersion = plan.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r *resourceTFETestVariable) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateVariableValueWO(ctx, req.Config)...)
+}
+
 func (r *resourceTFETestVariable) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data modelTFETestVariable
 	diags := req.State.Get(ctx, &data)
@@ -337,6 +376,11 @@ func (r *resourceTFETestVariable) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	resp.Diagnostics.Append(r.config.useDataOrganization(ctx, req.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	variableID := data.ID.ValueString()
 	moduleID := tfe.RegistryModuleID{
 		Organization: data.Organization.ValueString(),
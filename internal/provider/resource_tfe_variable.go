@@ -11,7 +11,9 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -36,16 +39,18 @@ type resourceTFEVariable struct {
 
 // modelTFEVariable maps the resource schema data to a struct.
 type modelTFEVariable struct {
-	ID            types.String `tfsdk:"id"`
-	Key           types.String `tfsdk:"key"`
-	Value         types.String `tfsdk:"value"`
-	ReadableValue types.String `tfsdk:"readable_value"`
-	Category      types.String `tfsdk:"category"`
-	Description   types.String `tfsdk:"description"`
-	HCL           types.Bool   `tfsdk:"hcl"`
-	Sensitive     types.Bool   `tfsdk:"sensitive"`
-	WorkspaceID   types.String `tfsdk:"workspace_id"`
-	VariableSetID types.String `tfsdk:"variable_set_id"`
+	ID             types.String `tfsdk:"id"`
+	Key            types.String `tfsdk:"key"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	ReadableValue  types.String `tfsdk:"readable_value"`
+	Category       types.String `tfsdk:"category"`
+	Description    types.String `tfsdk:"description"`
+	HCL            types.Bool   `tfsdk:"hcl"`
+	Sensitive      types.Bool   `tfsdk:"sensitive"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
+	VariableSetID  types.String `tfsdk:"variable_set_id"`
 }
 
 // modelFromTFEVariable builds a modelTFEVariable struct from a tfe.Variable
@@ -161,6 +166,8 @@ func (r *resourceTFEVariable) Schema(ctx context.Context, req resource.SchemaReq
 				Sensitive:   true,
 				Description: "Value of the variable",
 			},
+			"value_wo":         valueWOAttribute(),
+			"value_wo_version": valueWOVersionAttribute(),
 			"category": schema.StringAttribute{
 				Required:    true,
 				Description: `Whether this is a Terraform or environment variable. Valid values are "terraform" or "env".`,
@@ -259,6 +266,11 @@ func isWorkspaceVariable(ctx context.Context, data AttrGettable) bool {
 
 // Create implements resource.Resource
 func (r *resourceTFEVariable) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "workspace_id", "variable_set_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if isWorkspaceVariable(ctx, &req.Plan) {
 		r.createWithWorkspace(ctx, req, resp)
 	} else {
@@ -275,6 +287,12 @@ func (r *resourceTFEVariable) createWithWorkspace(ctx context.Context, req resou
 		return
 	}
 
+	valueWO, diags := configValueWO(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	key := data.Key.ValueString()
 	category := data.Category.ValueString()
 	workspaceID := data.WorkspaceID.ValueString()
@@ -287,6 +305,9 @@ func (r *resourceTFEVariable) createWithWorkspace(ctx context.Context, req resou
 		Sensitive:   data.Sensitive.ValueBoolPointer(),
 		Description: data.Description.ValueStringPointer(),
 	}
+	if !valueWO.IsNull() {
+		options.Value = valueWO.ValueStringPointer()
+	}
 
 	log.Printf("[DEBUG] Create %s variable: %s", category, key)
 	variable, err := r.config.Client.Variables.Create(ctx, workspaceID, options)
@@ -300,6 +321,7 @@ func (r *resourceTFEVariable) createWithWorkspace(ctx context.Context, req resou
 
 	// Got a variable back, so set state to new values
 	result := modelFromTFEVariable(*variable, data.Value)
+	result.ValueWOVersion = data.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
@@ -313,6 +335,12 @@ func (r *resourceTFEVariable) createWithVariableSet(ctx context.Context, req res
 		return
 	}
 
+	valueWO, diags := configValueWO(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	key := data.Key.ValueString()
 	category := data.Category.ValueString()
 	variableSetID := data.VariableSetID.ValueString()
@@ -325,6 +353,9 @@ func (r *resourceTFEVariable) createWithVariableSet(ctx context.Context, req res
 		Sensitive:   data.Sensitive.ValueBoolPointer(),
 		Description: data.Description.ValueStringPointer(),
 	}
+	if !valueWO.IsNull() {
+		options.Value = valueWO.ValueStringPointer()
+	}
 
 	log.Printf("[DEBUG] Create %s variable: %s", category, key)
 	variable, err := r.config.Client.VariableSetVariables.Create(ctx, variableSetID, &options)
@@ -338,12 +369,18 @@ func (r *resourceTFEVariable) createWithVariableSet(ctx context.Context, req res
 
 	// We got a variable, so set state to new values
 	result := modelFromTFEVariableSetVariable(*variable, data.Value)
+	result.ValueWOVersion = data.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
 
 // Read implements resource.Resource
 func (r *resourceTFEVariable) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "workspace_id", "variable_set_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if isWorkspaceVariable(ctx, &req.State) {
 		r.readWithWorkspace(ctx, req, resp)
 	} else {
@@ -379,6 +416,7 @@ func (r *resourceTFEVariable) readWithWorkspace(ctx context.Context, req resourc
 
 	// We got a variable, so update state:
 	result := modelFromTFEVariable(*variable, data.Value)
+	result.ValueWOVersion = data.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
@@ -411,12 +449,18 @@ func (r *resourceTFEVariable) readWithVariableSet(ctx context.Context, req resou
 
 	// We got a variable, so update state:
 	result := modelFromTFEVariableSetVariable(*variable, data.Value)
+	result.ValueWOVersion = data.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
 
 // Update implements resource.Resource
 func (r *resourceTFEVariable) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "workspace_id", "variable_set_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if isWorkspaceVariable(ctx, &req.Plan) {
 		r.updateWithWorkspace(ctx, req, resp)
 	} else {
@@ -439,6 +483,11 @@ func (r *resourceTFEVariable) updateWithWorkspace(ctx context.Context, req resou
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	valueWO, diags := configValueWO(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	variableID := plan.ID.ValueString()
 	workspaceID := plan.WorkspaceID.ValueString()
@@ -460,10 +509,9 @@ func (r *resourceTFEVariable) updateWithWorkspace(ctx context.Context, req resou
 	// condition of a sensitive variable, we don't KNOW whether setting it to
 	// our last-known value is a safe idempotent operation or not. This is why
 	// Terraform doesn't promise that it can manage drift at all for write-only
-	// attributes.)
-	if state.Value.ValueString() != plan.Value.ValueString() {
-		options.Value = plan.Value.ValueStringPointer()
-	}
+	// attributes.) The same goes for value_wo, which is only sent when
+	// value_wo_version changes.
+	options.Value = updatedVariableValue(plan.Value, state.Value, valueWO, plan.ValueWOVersion, state.ValueWOVersion)
 
 	log.Printf("[DEBUG] Update variable: %s", variableID)
 	variable, err := r.config.Client.Variables.Update(ctx, workspaceID, variableID, options)
@@ -476,6 +524,7 @@ func (r *resourceTFEVariable) updateWithWorkspace(ctx context.Context, req resou
 	}
 	// Update state
 	result := modelFromTFEVariable(*variable, plan.Value)
+	result.ValueWOVersion = plan.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
@@ -495,6 +544,11 @@ func (r *resourceTFEVariable) updateWithVariableSet(ctx context.Context, req res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	valueWO, diags := configValueWO(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	variableID := plan.ID.ValueString()
 	variableSetID := plan.VariableSetID.ValueString()
@@ -507,9 +561,7 @@ func (r *resourceTFEVariable) updateWithVariableSet(ctx context.Context, req res
 	}
 	// We ONLY want to set Value if our planned value would be a CHANGE from the
 	// prior state. See comments in updateWithWorkspace for more color.
-	if state.Value.ValueString() != plan.Value.ValueString() {
-		options.Value = plan.Value.ValueStringPointer()
-	}
+	options.Value = updatedVariableValue(plan.Value, state.Value, valueWO, plan.ValueWOVersion, state.ValueWOVersion)
 
 	log.Printf("[DEBUG] Update variable: %s", variableID)
 	variable, err := r.config.Client.VariableSetVariables.Update(ctx, variableSetID, variableID, options)
@@ -522,12 +574,23 @@ func (r *resourceTFEVariable) updateWithVariableSet(ctx context.Context, req res
 	}
 	// Update state
 	result := modelFromTFEVariableSetVariable(*variable, plan.Value)
+	result.ValueWOVersion = plan.ValueWOVersion
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
 
+// ValidateConfig implements resource.ResourceWithValidateConfig
+func (r *resourceTFEVariable) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateVariableValueWO(ctx, req.Config)...)
+}
+
 // Delete implements resource.Resource
 func (r *resourceTFEVariable) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "workspace_id", "variable_set_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if isWorkspaceVariable(ctx, &req.State) {
 		r.deleteWithWorkspace(ctx, req, resp)
 	} else {
@@ -649,7 +712,7 @@ func (r *resourceTFEVariable) UpgradeState(ctx context.Context) map[int64]resour
 				}
 				// Get the workspace external ID
 				oldWorkspaceID := oldData.WorkspaceID.ValueString()
-				newWorkspaceID, err := fetchWorkspaceExternalID(oldWorkspaceID, r.config.Client)
+				newWorkspaceID, err := fetchWorkspaceExternalID(ctx, oldWorkspaceID, r.config.Client)
 				if err != nil {
 					resp.Diagnostics.AddError(
 						"Error reading workspace",
@@ -684,7 +747,7 @@ func (r *resourceTFEVariable) ImportState(ctx context.Context, req resource.Impo
 	if len(s) != 3 {
 		resp.Diagnostics.AddError(
 			"Error importing variable",
-			fmt.Sprintf("Invalid variable import format: %s (expected <ORGANIZATION>/<WORKSPACE NAME|VARIABLE SET ID>/<VARIABLE ID>)", req.ID),
+			fmt.Sprintf("Invalid variable import format: %s (expected <ORGANIZATION>/<WORKSPACE NAME|VARIABLE SET ID>/<VARIABLE ID|VARIABLE KEY>)", req.ID),
 		)
 		return
 	}
@@ -692,6 +755,11 @@ func (r *resourceTFEVariable) ImportState(ctx context.Context, req resource.Impo
 	container := s[1]
 	id := s[2]
 
+	resp.Diagnostics.Append(r.config.useImportOrganization(org)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data := modelTFEVariable{
 		ID:            types.StringValue(id),
 		WorkspaceID:   types.StringNull(),
@@ -702,7 +770,7 @@ func (r *resourceTFEVariable) ImportState(ctx context.Context, req resource.Impo
 	if varsetIDUsed {
 		data.VariableSetID = types.StringValue(container)
 	} else {
-		workspaceID, err := fetchWorkspaceExternalID(org+"/"+container, r.config.Client)
+		workspaceID, err := fetchWorkspaceExternalID(ctx, org+"/"+container, r.config.Client)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing variable",
@@ -713,10 +781,121 @@ func (r *resourceTFEVariable) ImportState(ctx context.Context, req resource.Impo
 		data.WorkspaceID = types.StringValue(workspaceID)
 	}
 
+	// Variables can also be imported by key, as long as only one variable of
+	// the workspace or variable set has it.
+	if !isResourceIDFormat("var", id) {
+		variableID, err := fetchVariableIDByKey(ctx, r.config.Client, data, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing variable",
+				fmt.Sprintf("Couldn't retrieve variable %s from %s: %s", id, container, err.Error()),
+			)
+			return
+		}
+		data.ID = types.StringValue(variableID)
+	}
+
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
+// valueWOAttribute is the schema of the value_wo attribute shared by
+// tfe_variable and tfe_test_variable.
+func valueWOAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:  true,
+		WriteOnly: true,
+		Sensitive: true,
+		Description: "Write-only value of the variable, which is sent to TFE but never stored in the plan or state. " +
+			"Requires sensitive to be true, and is only sent again when value_wo_version changes.",
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(
+				path.MatchRelative().AtParent().AtName("value"),
+			),
+		},
+	}
+}
+
+// valueWOVersionAttribute is the schema of the value_wo_version attribute,
+// which triggers an update of value_wo when it changes.
+func valueWOVersionAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: "Version of value_wo. Changing it sends the current value_wo to TFE.",
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(
+				path.MatchRelative().AtParent().AtName("value_wo"),
+			),
+		},
+	}
+}
+
+// configValueWO reads the value_wo attribute from the configuration, the only
+// place it is present, as write-only values are null in the plan and state.
+func configValueWO(ctx context.Context, config tfsdk.Config) (types.String, diag.Diagnostics) {
+	var valueWO types.String
+	diags := config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)
+	return valueWO, diags
+}
+
+// validateVariableValueWO checks that a variable with a value_wo is sensitive,
+// because the value of a variable that isn't can be read back into state.
+func validateVariableValueWO(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var valueWO types.String
+	var sensitive types.Bool
+	diags := config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)
+	diags.Append(config.GetAttribute(ctx, path.Root("sensitive"), &sensitive)...)
+	if diags.HasError() || valueWO.IsNull() || sensitive.IsUnknown() {
+		return diags
+	}
+
+	if !sensitive.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("sensitive"),
+			"Invalid Attribute Combination",
+			"Expected sensitive to be true when value_wo is configured, so that the value is never read back into state.",
+		)
+	}
+	return diags
+}
+
+// updatedVariableValue returns the value to send when updating a variable, or
+// nil to leave it unchanged. A configured value_wo can't be compared to what
+// was last sent, so it is sent only when value_wo_version changes, or when it
+// replaces a value that was set with value; otherwise value is sent when it
+// changes.
+func updatedVariableValue(planValue, stateValue, valueWO types.String, planVersion, stateVersion types.Int64) *string {
+	if !valueWO.IsNull() {
+		if planVersion.Equal(stateVersion) && stateValue.ValueString() == "" {
+			return nil
+		}
+		return valueWO.ValueStringPointer()
+	}
+
+	if planValue.ValueString() != stateValue.ValueString() {
+		return planValue.ValueStringPointer()
+	}
+	return nil
+}
+
+// fetchVariableIDByKey returns the ID of the variable with the given key in
+// the workspace or variable set of data.
+func fetchVariableIDByKey(ctx context.Context, client *tfe.Client, data modelTFEVariable, key string) (string, error) {
+	if !data.VariableSetID.IsNull() {
+		v, err := fetchVariableSetVariableByKey(ctx, client, data.VariableSetID.ValueString(), key)
+		if err != nil {
+			return "", err
+		}
+		return v.ID, nil
+	}
+
+	v, err := fetchVariableByKey(ctx, client, data.WorkspaceID.ValueString(), key)
+	if err != nil {
+		return "", err
+	}
+	return v.ID, nil
+}
+
 type updateReadableValuePlanModifier struct{}
 
 func (u *updateReadableValuePlanModifier) Description(ctx context.Context) string {
@@ -754,6 +933,7 @@ func (u *updateReadableValuePlanModifier) PlanModifyString(ctx context.Context,
 // Compile-time interface check
 var _ resource.Resource = &resourceTFEVariable{}
 var _ resource.ResourceWithConfigure = &resourceTFEVariable{}
+var _ resource.ResourceWithValidateConfig = &resourceTFEVariable{}
 var _ resource.ResourceWithUpgradeState = &resourceTFEVariable{}
 var _ resource.ResourceWithImportState = &resourceTFEVariable{}
 var _ planmodifier.String = &updateReadableValuePlanModifier{}
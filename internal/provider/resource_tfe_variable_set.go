@@ -15,6 +15,7 @@ import (
 	"regexp"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,12 +23,13 @@ var variableSetIDRegexp = regexp.MustCompile("varset-[a-zA-Z0-9]{16}$")
 
 func resourceTFEVariableSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEVariableSetCreate,
-		Read:   resourceTFEVariableSetRead,
-		Update: resourceTFEVariableSetUpdate,
-		Delete: resourceTFEVariableSetDelete,
+		CreateContext: resourceTFEVariableSetCreate,
+		ReadContext:   resourceTFEVariableSetRead,
+		UpdateContext: resourceTFEVariableSetUpdate,
+		DeleteContext: resourceTFEVariableSetDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importByIDOrName("variable set", fetchVariableSetID),
 		},
 
 		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
@@ -89,14 +91,14 @@ func resourceTFEVariableSet() *schema.Resource {
 	}
 }
 
-func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -120,8 +122,8 @@ func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) erro
 
 	variableSet, err := config.Client.VariableSets.Create(ctx, organization, &options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating variable set %s, for organization: %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating variable set %s, for organization: %s: %v", name, organization, err)
 	}
 
 	d.SetId(variableSet.ID)
@@ -139,15 +141,15 @@ func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) erro
 
 		_, err := config.Client.VariableSets.UpdateWorkspaces(ctx, variableSet.ID, &applyOptions)
 		if err != nil {
-			return fmt.Errorf(
-				"Error applying variable set %s (%s) to given workspaces: %w", name, variableSet.ID, err)
+			return diag.Errorf(
+				"Error applying variable set %s (%s) to given workspaces: %v", name, variableSet.ID, err)
 		}
 	}
 
-	return resourceTFEVariableSetRead(d, meta)
+	return resourceTFEVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of variable set: %s", d.Id())
@@ -160,7 +162,7 @@ func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of variable set %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of variable set %s: %v", d.Id(), err)
 	}
 
 	// Update the config.
@@ -183,7 +185,7 @@ func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	if d.HasChange("name") || d.HasChange("description") || d.HasChange("global") || d.HasChange("priority") {
@@ -197,7 +199,7 @@ func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Update variable set: %s", d.Id())
 		_, err := config.Client.VariableSets.Update(ctx, d.Id(), &options)
 		if err != nil {
-			return fmt.Errorf("Error updating variable %s: %w", d.Id(), err)
+			return diag.Errorf("Error updating variable %s: %v", d.Id(), err)
 		}
 	}
 
@@ -215,15 +217,15 @@ func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) erro
 		warnWorkspaceIdsDeprecation()
 		_, err := config.Client.VariableSets.UpdateWorkspaces(ctx, d.Id(), &applyOptions)
 		if err != nil {
-			return fmt.Errorf(
-				"Error applying variable set %s to given workspaces: %w", d.Id(), err)
+			return diag.Errorf(
+				"Error applying variable set %s to given workspaces: %v", d.Id(), err)
 		}
 	}
 
-	return resourceTFEVariableSetRead(d, meta)
+	return resourceTFEVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEVariableSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete variable set: %s", d.Id())
@@ -232,7 +234,7 @@ func resourceTFEVariableSetDelete(d *schema.ResourceData, meta interface{}) erro
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting variable set %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting variable set %s: %v", d.Id(), err)
 	}
 
 	return nil
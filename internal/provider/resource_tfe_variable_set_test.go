@@ -140,6 +140,12 @@ func TestAccTFEVariableSet_import(t *testing.T) {
 				ImportStateIdPrefix: "",
 				ImportStateVerify:   true,
 			},
+			{
+				ResourceName:      "tfe_variable_set.foobar",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("tst-terraform-%d/variable_set_test", rInt),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -8,10 +8,12 @@ import (
 	"math/rand"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -359,6 +361,155 @@ func TestAccTFEVariable_varset_readable_value_becomes_sensitive(t *testing.T) {
 	})
 }
 
+func TestAccTFEVariable_value_wo(t *testing.T) {
+	variable := &tfe.Variable{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		CheckDestroy:             testAccCheckTFEVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEVariable_value_wo(rInt, "value_test", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEVariableExists(
+						"tfe_variable.foobar", variable),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value", ""),
+					resource.TestCheckNoResourceAttr(
+						"tfe_variable.foobar", "value_wo"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value_wo_version", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "sensitive", "true"),
+				),
+			},
+			{
+				Config: testAccTFEVariable_value_wo(rInt, "value_updated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEVariableExists(
+						"tfe_variable.foobar", variable),
+					resource.TestCheckNoResourceAttr(
+						"tfe_variable.foobar", "value_wo"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEVariable_varset_value_wo(t *testing.T) {
+	variable := &tfe.VariableSetVariable{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEVariable_varset_value_wo(rInt, "value_test", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEVariableSetVariableExists(
+						"tfe_variable.foobar", variable),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value", ""),
+					resource.TestCheckNoResourceAttr(
+						"tfe_variable.foobar", "value_wo"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccTFEVariable_varset_value_wo(rInt, "value_updated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEVariable_value_wo_not_sensitive(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testAccTFEVariable_value_wo(rInt, "value_test", 1), "sensitive        = true", "sensitive        = false", 1),
+				ExpectError: regexp.MustCompile(`Expected sensitive to be true when value_wo is configured`),
+			},
+		},
+	})
+}
+
+func TestUpdatedVariableValue(t *testing.T) {
+	cases := map[string]struct {
+		planValue, stateValue, valueWO types.String
+		planVersion, stateVersion      types.Int64
+		expected                       *string
+	}{
+		"value unchanged": {
+			planValue:  types.StringValue("a"),
+			stateValue: types.StringValue("a"),
+			valueWO:    types.StringNull(),
+		},
+		"value changed": {
+			planValue:  types.StringValue("b"),
+			stateValue: types.StringValue("a"),
+			valueWO:    types.StringNull(),
+			expected:   tfe.String("b"),
+		},
+		"value_wo version unchanged": {
+			planValue:    types.StringValue(""),
+			stateValue:   types.StringValue(""),
+			valueWO:      types.StringValue("secret"),
+			planVersion:  types.Int64Value(1),
+			stateVersion: types.Int64Value(1),
+		},
+		"value_wo version changed": {
+			planValue:    types.StringValue(""),
+			stateValue:   types.StringValue(""),
+			valueWO:      types.StringValue("secret"),
+			planVersion:  types.Int64Value(2),
+			stateVersion: types.Int64Value(1),
+			expected:     tfe.String("secret"),
+		},
+		"value replaced by value_wo": {
+			planValue:    types.StringValue(""),
+			stateValue:   types.StringValue("a"),
+			valueWO:      types.StringValue("secret"),
+			planVersion:  types.Int64Value(1),
+			stateVersion: types.Int64Null(),
+			expected:     tfe.String("secret"),
+		},
+		"value replaced by value_wo without a version": {
+			planValue:    types.StringValue(""),
+			stateValue:   types.StringValue("a"),
+			valueWO:      types.StringValue("secret"),
+			planVersion:  types.Int64Null(),
+			stateVersion: types.Int64Null(),
+			expected:     tfe.String("secret"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual := updatedVariableValue(c.planValue, c.stateValue, c.valueWO, c.planVersion, c.stateVersion)
+			switch {
+			case c.expected == nil && actual != nil:
+				t.Fatalf("Expected the value to be left unchanged, got %q", *actual)
+			case c.expected != nil && (actual == nil || *actual != *c.expected):
+				t.Fatalf("Expected the value %q, got %v", *c.expected, actual)
+			}
+		})
+	}
+}
+
 func TestAccTFEVariable_import(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -866,3 +1017,47 @@ resource "tfe_workspace" "downstream-nonreadable" {
 }
 `, rIntOrg, rIntVariableValue, strconv.FormatBool(sensitive))
 }
+
+func testAccTFEVariable_value_wo(rInt int, value string, version int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_variable" "foobar" {
+  key              = "key_test"
+  value_wo         = "%s"
+  value_wo_version = %d
+  category         = "env"
+  sensitive        = true
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt, value, version)
+}
+
+func testAccTFEVariable_varset_value_wo(rInt int, value string, version int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_variable_set" "foobar" {
+  name         = "varset-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_variable" "foobar" {
+  key              = "key_test"
+  value_wo         = "%s"
+  value_wo_version = %d
+  category         = "env"
+  sensitive        = true
+  variable_set_id  = tfe_variable_set.foobar.id
+}`, rInt, value, version)
+}
@@ -20,6 +20,7 @@ import (
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/jsonapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,10 +30,11 @@ var workspaceIDRegexp = regexp.MustCompile("^ws-[a-zA-Z0-9]{16}$")
 
 func resourceTFEWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspaceCreate,
-		Read:   resourceTFEWorkspaceRead,
-		Update: resourceTFEWorkspaceUpdate,
-		Delete: resourceTFEWorkspaceDelete,
+		CreateContext: resourceTFEWorkspaceCreate,
+		ReadContext:   resourceTFEWorkspaceRead,
+		UpdateContext: resourceTFEWorkspaceUpdate,
+		DeleteContext: resourceTFEWorkspaceDelete,
+		Timeouts:      resourceTimeouts(true),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspaceImporter,
 		},
@@ -55,10 +57,18 @@ func resourceTFEWorkspace() *schema.Resource {
 				return err
 			}
 
+			if err := customizeDiffTagsAll(c, d, meta); err != nil {
+				return err
+			}
+
 			if err := customizeDiffIfProviderDefaultOrganizationChanged(c, d, meta); err != nil {
 				return err
 			}
 
+			if err := customizeDiffCapabilities("tfe_workspace")(c, d, meta); err != nil {
+				return err
+			}
+
 			if err := customizeDiffAutoDestroyAt(c, d); err != nil {
 				return err
 			}
@@ -236,6 +246,12 @@ func resourceTFEWorkspace() *schema.Resource {
 				Optional: true,
 			},
 
+			"tags_all": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"terraform_version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -323,14 +339,14 @@ func resourceTFEWorkspace() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -365,7 +381,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 	if _, ok := d.GetOk("auto_destroy_at"); ok {
 		autoDestroyAt, err := expandAutoDestroyAt(d)
 		if err != nil {
-			return fmt.Errorf("Error expanding auto destroy during create: %w", err)
+			return diag.Errorf("Error expanding auto destroy during create: %v", err)
 		}
 		options.AutoDestroyAt = autoDestroyAt
 	}
@@ -460,7 +476,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	for _, tagName := range d.Get("tag_names").(*schema.Set).List() {
+	for _, tagName := range d.Get("tags_all").(*schema.Set).List() {
 		name := tagName.(string)
 		options.Tags = append(options.Tags, &tfe.Tag{Name: name})
 	}
@@ -468,8 +484,8 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create workspace %s for organization: %s", name, organization)
 	workspace, err := config.Client.Workspaces.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating workspace %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating workspace %s for organization %s: %v", name, organization, err)
 	}
 
 	d.SetId(workspace.ID)
@@ -479,7 +495,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 			SSHKeyID: tfe.String(sshKeyID.(string)),
 		})
 		if err != nil {
-			return fmt.Errorf("Error assigning SSH key to workspace %s: %w", name, err)
+			return diag.Errorf("Error assigning SSH key to workspace %s: %v", name, err)
 		}
 	}
 
@@ -491,14 +507,14 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 		err = config.Client.Workspaces.AddRemoteStateConsumers(ctx, workspace.ID, options)
 		if err != nil {
-			return fmt.Errorf("Error adding remote state consumers to workspace %s: %w", name, err)
+			return diag.Errorf("Error adding remote state consumers to workspace %s: %v", name, err)
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
+	return resourceTFEWorkspaceRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	id := d.Id()
@@ -510,7 +526,7 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of workspace %s: %w", id, err)
+		return diag.Errorf("Error reading configuration of workspace %s: %v", id, err)
 	}
 
 	// Update the config.
@@ -569,27 +585,40 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 
 	autoDestroyAt, err := flattenAutoDestroyAt(workspace.AutoDestroyAt)
 	if err != nil {
-		return fmt.Errorf("Error flattening auto destroy during read: %w", err)
+		return diag.Errorf("Error flattening auto destroy during read: %v", err)
 	}
 	d.Set("auto_destroy_at", autoDestroyAt)
 
 	if workspace.AutoDestroyActivityDuration.IsSpecified() {
 		v, err := workspace.AutoDestroyActivityDuration.Get()
 		if err != nil {
-			return fmt.Errorf("Error reading auto destroy activity duration: %w", err)
+			return diag.Errorf("Error reading auto destroy activity duration: %v", err)
 		}
 
 		d.Set("auto_destroy_activity_duration", v)
 	}
 
-	var tagNames []interface{}
+	// Default tags are only reported in tag_names when they are also set on
+	// the workspace itself.
+	var tagNames, tagsAll []interface{}
 	managedTags := d.Get("tag_names").(*schema.Set)
+	defaultTags := schema.NewSet(schema.HashString, nil)
+	for _, tagName := range config.defaultTagNames {
+		defaultTags.Add(tagName)
+	}
 	for _, tagName := range workspace.TagNames {
-		if managedTags.Contains(tagName) || !d.Get("ignore_additional_tag_names").(bool) {
+		managed := managedTags.Contains(tagName) || defaultTags.Contains(tagName)
+		if !managed && d.Get("ignore_additional_tag_names").(bool) {
+			continue
+		}
+
+		tagsAll = append(tagsAll, tagName)
+		if managedTags.Contains(tagName) || !defaultTags.Contains(tagName) {
 			tagNames = append(tagNames, tagName)
 		}
 	}
 	d.Set("tag_names", tagNames)
+	d.Set("tags_all", tagsAll)
 
 	var vcsRepo []interface{}
 	if workspace.VCSRepo != nil {
@@ -609,10 +638,10 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	if workspace.GlobalRemoteState {
 		d.Set("global_remote_state", true)
 	} else {
-		globalRemoteState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(id, config.Client)
+		globalRemoteState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ctx, id, config.Client)
 		if err != nil {
-			return fmt.Errorf(
-				"Error reading remote state consumers for workspace %s: %w", id, err)
+			return diag.Errorf(
+				"Error reading remote state consumers for workspace %s: %v", id, err)
 		}
 
 		d.Set("global_remote_state", globalRemoteState)
@@ -622,7 +651,7 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 	id := d.Id()
 
@@ -691,7 +720,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		if hasAutoDestroyAtChange(d) {
 			autoDestroyAt, err := expandAutoDestroyAt(d)
 			if err != nil {
-				return fmt.Errorf("Error expanding auto destroy during update: %w", err)
+				return diag.Errorf("Error expanding auto destroy during update: %v", err)
 			}
 			options.AutoDestroyAt = autoDestroyAt
 		}
@@ -780,7 +809,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 				_, err := config.Client.Workspaces.RemoveVCSConnectionByID(ctx, id)
 				if err != nil {
 					d.Partial(true)
-					return fmt.Errorf("Error removing VCS repo from workspace %s: %w", id, err)
+					return diag.Errorf("Error removing VCS repo from workspace %s: %v", id, err)
 				}
 			}
 		}
@@ -789,8 +818,8 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		_, err := config.Client.Workspaces.UpdateByID(ctx, id, options)
 		if err != nil {
 			d.Partial(true)
-			return fmt.Errorf(
-				"Error updating workspace %s: %w", id, err)
+			return diag.Errorf(
+				"Error updating workspace %s: %v", id, err)
 		}
 	}
 
@@ -806,18 +835,18 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 				},
 			)
 			if err != nil {
-				return fmt.Errorf("Error assigning SSH key to workspace %s: %w", id, err)
+				return diag.Errorf("Error assigning SSH key to workspace %s: %v", id, err)
 			}
 		} else {
 			_, err := config.Client.Workspaces.UnassignSSHKey(ctx, id)
 			if err != nil {
-				return fmt.Errorf("Error unassigning SSH key from workspace %s: %w", id, err)
+				return diag.Errorf("Error unassigning SSH key from workspace %s: %v", id, err)
 			}
 		}
 	}
 
-	if d.HasChange("tag_names") {
-		oldTagNameValues, newTagNameValues := d.GetChange("tag_names")
+	if d.HasChange("tags_all") {
+		oldTagNameValues, newTagNameValues := d.GetChange("tags_all")
 		newTagNamesSet := newTagNameValues.(*schema.Set)
 		oldTagNamesSet := oldTagNameValues.(*schema.Set)
 
@@ -836,7 +865,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Adding tags to workspace: %s", d.Id())
 			err := config.Client.Workspaces.AddTags(ctx, d.Id(), tfe.WorkspaceAddTagsOptions{Tags: addTags})
 			if err != nil {
-				return fmt.Errorf("Error adding tags to workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding tags to workspace %s: %v", d.Id(), err)
 			}
 		}
 
@@ -851,7 +880,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Removing tags from workspace: %s", d.Id())
 			err := config.Client.Workspaces.RemoveTags(ctx, d.Id(), tfe.WorkspaceRemoveTagsOptions{Tags: removeTags})
 			if err != nil {
-				return fmt.Errorf("Error removing tags from workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing tags from workspace %s: %v", d.Id(), err)
 			}
 		}
 	}
@@ -876,7 +905,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Adding remote state consumers to workspace: %s", d.Id())
 			err := config.Client.Workspaces.AddRemoteStateConsumers(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error adding remote state consumers to workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding remote state consumers to workspace %s: %v", d.Id(), err)
 			}
 		}
 
@@ -891,12 +920,12 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Removing remote state consumers from workspace: %s", d.Id())
 			err := config.Client.Workspaces.RemoveRemoteStateConsumers(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error removing remote state consumers from workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing remote state consumers from workspace %s: %v", d.Id(), err)
 			}
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
+	return resourceTFEWorkspaceRead(ctx, d, meta)
 }
 
 func safeWorkspaceDelete(ctx context.Context, config ConfiguredClient, id string) error {
@@ -911,7 +940,7 @@ func safeWorkspaceDelete(ctx context.Context, config ConfiguredClient, id string
 	})
 }
 
-func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 	id := d.Id()
 
@@ -922,8 +951,8 @@ func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf(
-			"Error reading workspace %s: %w", id, err)
+		return diag.Errorf(
+			"Error reading workspace %s: %v", id, err)
 	}
 
 	forceDelete := d.Get("force_delete").(bool)
@@ -933,7 +962,7 @@ func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error
 		if forceDelete {
 			err = config.Client.Workspaces.DeleteByID(ctx, id)
 		} else {
-			return fmt.Errorf(
+			return diag.Errorf(
 				"Error deleting workspace %s: This version of Terraform Enterprise does not support workspace safe-delete. Workspaces must be force deleted by setting force_delete=true", id)
 		}
 	} else if *ws.Permissions.CanForceDelete {
@@ -942,20 +971,20 @@ func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = errWorkspaceResourceCountCheck(id, ws.ResourceCount)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			err = safeWorkspaceDelete(ctx, config, id)
-			return errWorkspaceSafeDeleteWithPermission(id, err)
+			return diag.FromErr(errWorkspaceSafeDeleteWithPermission(id, err))
 		}
 	} else {
 		if forceDelete {
-			return fmt.Errorf(
+			return diag.Errorf(
 				"Error deleting workspace %s: missing required permissions to set force delete workspaces in the organization", id)
 		}
 		err = errWorkspaceResourceCountCheck(id, ws.ResourceCount)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = safeWorkspaceDelete(ctx, config, id)
 	}
@@ -964,8 +993,8 @@ func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf(
-			"Error deleting workspace %s: %w", id, err)
+		return diag.Errorf(
+			"Error deleting workspace %s: %v", id, err)
 	}
 	return nil
 }
@@ -1030,6 +1059,26 @@ func validateTagNames(_ context.Context, d *schema.ResourceDiff) error {
 	return nil
 }
 
+// customizeDiffTagsAll plans tags_all, the tag names of the workspace merged
+// with the default tags of the provider.
+func customizeDiffTagsAll(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	if !d.NewValueKnown("tag_names") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tagsAll := schema.NewSet(schema.HashString, d.Get("tag_names").(*schema.Set).List())
+	for _, tagName := range config.defaultTagNames {
+		tagsAll.Add(tagName)
+	}
+
+	if tagsAll.Equal(d.Get("tags_all")) {
+		return nil
+	}
+	return d.SetNew("tags_all", tagsAll.List())
+}
+
 func resourceTFEWorkspaceImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(ConfiguredClient)
 
@@ -1040,7 +1089,7 @@ func resourceTFEWorkspaceImporter(ctx context.Context, d *schema.ResourceData, m
 			d.Id(),
 		)
 	} else if len(s) == 2 {
-		workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], config.Client)
+		workspaceID, err := fetchWorkspaceExternalID(ctx, s[0]+"/"+s[1], config.Client)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving workspace with name %s from organization %s %w", s[1], s[0], err)
@@ -16,14 +16,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEWorkspacePolicySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspacePolicySetCreate,
-		Read:   resourceTFEWorkspacePolicySetRead,
-		Delete: resourceTFEWorkspacePolicySetDelete,
+		CreateContext: resourceTFEWorkspacePolicySetCreate,
+		ReadContext:   resourceTFEWorkspacePolicySetRead,
+		DeleteContext: resourceTFEWorkspacePolicySetDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspacePolicySetImporter,
 		},
@@ -44,7 +46,7 @@ func resourceTFEWorkspacePolicySet() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspacePolicySetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -55,16 +57,16 @@ func resourceTFEWorkspacePolicySetCreate(d *schema.ResourceData, meta interface{
 
 	err := config.Client.PolicySets.AddWorkspaces(ctx, policySetID, policySetAddWorkspacesOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error attaching policy set id %s to workspace %s: %w", policySetID, workspaceID, err)
+		return diag.Errorf(
+			"Error attaching policy set id %s to workspace %s: %v", policySetID, workspaceID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s", workspaceID, policySetID))
 
-	return resourceTFEWorkspacePolicySetRead(d, meta)
+	return resourceTFEWorkspacePolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspacePolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -78,7 +80,7 @@ func resourceTFEWorkspacePolicySetRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of policy set %s: %w", policySetID, err)
+		return diag.Errorf("Error reading configuration of policy set %s: %v", policySetID, err)
 	}
 
 	isWorkspaceAttached := false
@@ -100,7 +102,7 @@ func resourceTFEWorkspacePolicySetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEWorkspacePolicySetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -112,8 +114,8 @@ func resourceTFEWorkspacePolicySetDelete(d *schema.ResourceData, meta interface{
 
 	err := config.Client.PolicySets.RemoveWorkspaces(ctx, policySetID, policySetRemoveWorkspacesOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error detaching workspace %s from policy set %s: %w", workspaceID, policySetID, err)
+		return diag.Errorf(
+			"Error detaching workspace %s from policy set %s: %v", workspaceID, policySetID, err)
 	}
 
 	return nil
@@ -16,14 +16,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEWorkspacePolicySetExclusion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspacePolicySetExclusionCreate,
-		Read:   resourceTFEWorkspacePolicySetExclusionRead,
-		Delete: resourceTFEWorkspacePolicySetExclusionDelete,
+		CreateContext: resourceTFEWorkspacePolicySetExclusionCreate,
+		ReadContext:   resourceTFEWorkspacePolicySetExclusionRead,
+		DeleteContext: resourceTFEWorkspacePolicySetExclusionDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspacePolicySetExclusionImporter,
 		},
@@ -44,7 +46,7 @@ func resourceTFEWorkspacePolicySetExclusion() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspacePolicySetExclusionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetExclusionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -55,16 +57,16 @@ func resourceTFEWorkspacePolicySetExclusionCreate(d *schema.ResourceData, meta i
 
 	err := config.Client.PolicySets.AddWorkspaceExclusions(ctx, policySetID, policySetAddWorkspaceExclusionsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error adding workspace exclusion %s to policy set id %s: %w", workspaceExclusionID, policySetID, err)
+		return diag.Errorf(
+			"error adding workspace exclusion %s to policy set id %s: %v", workspaceExclusionID, policySetID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s", workspaceExclusionID, policySetID))
 
-	return resourceTFEWorkspacePolicySetExclusionRead(d, meta)
+	return resourceTFEWorkspacePolicySetExclusionRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspacePolicySetExclusionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetExclusionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -78,7 +80,7 @@ func resourceTFEWorkspacePolicySetExclusionRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading configuration of policy set %s: %w", policySetID, err)
+		return diag.Errorf("error reading configuration of policy set %s: %v", policySetID, err)
 	}
 
 	isWorkspaceExclusionsAttached := false
@@ -100,7 +102,7 @@ func resourceTFEWorkspacePolicySetExclusionRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTFEWorkspacePolicySetExclusionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetExclusionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -112,8 +114,8 @@ func resourceTFEWorkspacePolicySetExclusionDelete(d *schema.ResourceData, meta i
 
 	err := config.Client.PolicySets.RemoveWorkspaceExclusions(ctx, policySetID, policySetRemoveWorkspaceExclusionsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error removing excluded workspace %s from policy set %s: %w", workspaceExclusionsID, policySetID, err)
+		return diag.Errorf(
+			"error removing excluded workspace %s from policy set %s: %v", workspaceExclusionsID, policySetID, err)
 	}
 
 	return nil
@@ -9,11 +9,12 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"log"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -70,10 +71,11 @@ var policyOverridePendingStatuses = map[tfe.RunStatus]bool{
 
 func resourceTFEWorkspaceRun() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceTFEWorkspaceRunCreate,
-		Delete:        resourceTFEWorkspaceRunDelete,
-		Read:          resourceTFEWorkspaceRunRead,
-		Update:        resourceTFEWorkspaceRunUpdate,
+		CreateContext: resourceTFEWorkspaceRunCreate,
+		DeleteContext: resourceTFEWorkspaceRunDelete,
+		ReadContext:   resourceTFEWorkspaceRunRead,
+		UpdateContext: resourceTFEWorkspaceRunUpdate,
+		Timeouts:      resourceTimeouts(true),
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
@@ -98,26 +100,26 @@ func resourceTFEWorkspaceRun() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspaceRunCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// var isDestroyRun & currentRetryAttempts is declared for the sole purpose of code readability
 	isDestroyRun := false
 	currentRetryAttempts := 0
-	return createWorkspaceRun(d, meta, isDestroyRun, currentRetryAttempts)
+	return diag.FromErr(createWorkspaceRun(ctx, d, meta, isDestroyRun, currentRetryAttempts))
 }
 
-func resourceTFEWorkspaceRunDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// var isDestroyRun & currentRetryAttempts is declared for the sole purpose of code readability
 	isDestroyRun := true
 	currentRetryAttempts := 0
-	return createWorkspaceRun(d, meta, isDestroyRun, currentRetryAttempts)
+	return diag.FromErr(createWorkspaceRun(ctx, d, meta, isDestroyRun, currentRetryAttempts))
 }
 
-func resourceTFEWorkspaceRunUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRunUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// update is a noop since this resource only creates a run during a destroy or an initial apply phase
 	return nil
 }
 
-func resourceTFEWorkspaceRunRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// First check whether this is a destroy-only run
 	_, ok := d.GetOk("apply")
 	if !ok {
@@ -143,7 +145,7 @@ func resourceTFEWorkspaceRunRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading run %s: %w", d.Id(), err)
+		return diag.Errorf("error reading run %s: %v", d.Id(), err)
 	}
 
 	return nil
@@ -59,12 +59,13 @@ type resourceWorkspaceRunTask struct {
 var _ resource.Resource = &resourceWorkspaceRunTask{}
 var _ resource.ResourceWithConfigure = &resourceWorkspaceRunTask{}
 var _ resource.ResourceWithImportState = &resourceWorkspaceRunTask{}
+var _ resource.ResourceWithModifyPlan = &resourceWorkspaceRunTask{}
 
 func NewWorkspaceRunTaskResource() resource.Resource {
 	return &resourceWorkspaceRunTask{}
 }
 
-func modelFromTFEWorkspaceRunTask(v *tfe.WorkspaceRunTask) modelTFEWorkspaceRunTaskV1 {
+func modelFromTFEWorkspaceRunTask(ctx context.Context, v *tfe.WorkspaceRunTask) modelTFEWorkspaceRunTaskV1 {
 	result := modelTFEWorkspaceRunTaskV1{
 		ID:               types.StringValue(v.ID),
 		WorkspaceID:      types.StringValue(v.Workspace.ID),
@@ -100,7 +101,12 @@ func (r *resourceWorkspaceRunTask) Configure(ctx context.Context, req resource.C
 		)
 	}
 	r.config = client
-	r.capabilities = newDefaultCapabilityResolver(client.Client)
+	r.capabilities = client.capabilityResolver(client.Organization)
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan
+func (r *resourceWorkspaceRunTask) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanForCapabilities(ctx, r.capabilities, "tfe_workspace_run_task", req, resp)
 }
 
 func (r *resourceWorkspaceRunTask) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -108,6 +114,11 @@ func (r *resourceWorkspaceRunTask) Schema(ctx context.Context, req resource.Sche
 }
 
 func (r *resourceWorkspaceRunTask) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "workspace_id", "task_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state modelTFEWorkspaceRunTaskV1
 
 	// Read Terraform current state into the model
@@ -130,13 +141,18 @@ func (r *resourceWorkspaceRunTask) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	result := modelFromTFEWorkspaceRunTask(wstask)
+	result := modelFromTFEWorkspaceRunTask(ctx, wstask)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 }
 
 func (r *resourceWorkspaceRunTask) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "workspace_id", "task_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan modelTFEWorkspaceRunTaskV1
 
 	// Read Terraform planned changes into the model
@@ -165,7 +181,7 @@ func (r *resourceWorkspaceRunTask) Create(ctx context.Context, req resource.Crea
 		EnforcementLevel: level,
 	}
 
-	stage, stages := r.extractStageAndStages(plan, &resp.Diagnostics)
+	stage, stages := r.extractStageAndStages(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -184,7 +200,7 @@ func (r *resourceWorkspaceRunTask) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	result := modelFromTFEWorkspaceRunTask(wstask)
+	result := modelFromTFEWorkspaceRunTask(ctx, wstask)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -199,6 +215,11 @@ func (r *resourceWorkspaceRunTask) stringPointerToStagePointer(val *string) *tfe
 }
 
 func (r *resourceWorkspaceRunTask) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "workspace_id", "task_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan modelTFEWorkspaceRunTaskV1
 
 	// Read Terraform planned changes into the model
@@ -213,7 +234,7 @@ func (r *resourceWorkspaceRunTask) Update(ctx context.Context, req resource.Upda
 		EnforcementLevel: level,
 	}
 
-	stage, stages := r.extractStageAndStages(plan, &resp.Diagnostics)
+	stage, stages := r.extractStageAndStages(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -235,13 +256,18 @@ func (r *resourceWorkspaceRunTask) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	result := modelFromTFEWorkspaceRunTask(wstask)
+	result := modelFromTFEWorkspaceRunTask(ctx, wstask)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 }
 
 func (r *resourceWorkspaceRunTask) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "workspace_id", "task_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state modelTFEWorkspaceRunTaskV1
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -278,7 +304,7 @@ func (r *resourceWorkspaceRunTask) ImportState(ctx context.Context, req resource
 	workspaceName := s[1]
 	orgName := s[0]
 
-	if wstask, err := fetchWorkspaceRunTask(taskName, workspaceName, orgName, r.config.Client); err != nil {
+	if wstask, err := fetchWorkspaceRunTask(ctx, taskName, workspaceName, orgName, r.config.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing workspace run task",
 			err.Error(),
@@ -289,7 +315,7 @@ func (r *resourceWorkspaceRunTask) ImportState(ctx context.Context, req resource
 			"Workspace task does not exist or has no details",
 		)
 	} else {
-		result := modelFromTFEWorkspaceRunTask(wstask)
+		result := modelFromTFEWorkspaceRunTask(ctx, wstask)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 	}
 }
@@ -318,7 +344,7 @@ func (r *resourceWorkspaceRunTask) UpgradeState(ctx context.Context) map[int64]r
 					return
 				}
 
-				newData := modelFromTFEWorkspaceRunTask(wstask)
+				newData := modelFromTFEWorkspaceRunTask(ctx, wstask)
 				diags = resp.State.Set(ctx, newData)
 				resp.Diagnostics.Append(diags...)
 			},
@@ -327,12 +353,11 @@ func (r *resourceWorkspaceRunTask) UpgradeState(ctx context.Context) map[int64]r
 }
 
 func (r *resourceWorkspaceRunTask) supportsStagesProperty() bool {
-	// The Stages property is available in HCP Terraform and Terraform Enterprise v202404-1 onwards.
-	//
-	// The version comparison here can use plain string comparisons due to the nature of the naming scheme. If
-	// TFE every changes its scheme, the comparison will be problematic.
+	// The Stages property is available in HCP Terraform and Terraform Enterprise v202404-1 onwards. Unlike other
+	// capabilities, a single stage is sent as the deprecated stage attribute to older releases, so the plan isn't
+	// failed when it's unsupported.
 	if r.supportsStages == nil {
-		value := r.capabilities.IsCloud() || r.capabilities.RemoteTFEVersion() > "v202404"
+		value := checkCapability(r.capabilities, "tfe_workspace_run_task.stages") == nil
 		r.supportsStages = &value
 	}
 	return *r.supportsStages
@@ -348,7 +373,7 @@ func (r *resourceWorkspaceRunTask) addStageSupportDiag(d *diag.Diagnostics, isEr
 	}
 }
 
-func (r *resourceWorkspaceRunTask) extractStageAndStages(plan modelTFEWorkspaceRunTaskV1, d *diag.Diagnostics) (*tfe.Stage, []tfe.Stage) {
+func (r *resourceWorkspaceRunTask) extractStageAndStages(ctx context.Context, plan modelTFEWorkspaceRunTaskV1, d *diag.Diagnostics) (*tfe.Stage, []tfe.Stage) {
 	// There are some complex interactions here between deprecated values in the TF model, and whether the backend server even supports the newer
 	// API call style. This function attempts to extract the Stage and Stages properties and emit useful diagnostics
 
@@ -26,6 +26,7 @@ import (
 
 // tfe_workspace_settings resource
 var _ resource.Resource = &workspaceSettings{}
+var _ resource.ResourceWithMoveState = &workspaceSettings{}
 
 // overwritesElementType is the object type definition for the
 // overwrites field schema.
@@ -117,7 +118,7 @@ func (m validateAgentExecutionMode) MarkdownDescription(_ context.Context) strin
 	return "Validates that configuration values for \"agent_pool_id\" and \"execution_mode\" are compatible"
 }
 
-func (m validateRemoteStateConsumerIDs) PlanModifySet(_ context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+func (m validateRemoteStateConsumerIDs) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
 	var remoteStateConsumerIDs types.Set
 	diags := req.Config.GetAttribute(ctx, path.Root("remote_state_consumer_ids"), &remoteStateConsumerIDs)
 	if diags.HasError() {
@@ -152,7 +153,7 @@ func (m validateRemoteStateConsumerIDs) MarkdownDescription(_ context.Context) s
 	return "Validates that configuration values for \"global_remote_state\" and \"remote_state_consumer_ids\" are compatible"
 }
 
-func (m validateSelfReference) PlanModifySet(_ context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+func (m validateSelfReference) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
 	var remoteStateConsumerIDSet types.Set
 	diags := req.Plan.GetAttribute(ctx, path.Root("remote_state_consumer_ids"), &remoteStateConsumerIDSet)
 	if diags.HasError() {
@@ -343,7 +344,7 @@ func (r *workspaceSettings) Schema(ctx context.Context, req resource.SchemaReque
 }
 
 // workspaceSettingsModelFromTFEWorkspace builds a resource model from the TFE model
-func (r *workspaceSettings) workspaceSettingsModelFromTFEWorkspace(ws *tfe.Workspace) *modelWorkspaceSettings {
+func (r *workspaceSettings) workspaceSettingsModelFromTFEWorkspace(ctx context.Context, ws *tfe.Workspace) *modelWorkspaceSettings {
 	result := modelWorkspaceSettings{
 		ID:                types.StringValue(ws.ID),
 		WorkspaceID:       types.StringValue(ws.ID),
@@ -357,7 +358,7 @@ func (r *workspaceSettings) workspaceSettingsModelFromTFEWorkspace(ws *tfe.Works
 
 	result.RemoteStateConsumerIDs = types.SetNull(types.StringType)
 
-	_, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ws.ID, r.config.Client)
+	_, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ctx, ws.ID, r.config.Client)
 	if err != nil {
 		log.Printf("[ERROR] Error reading remote state consumers for workspace %s: %s", ws.ID, err)
 		return nil
@@ -391,6 +392,11 @@ func (r *workspaceSettings) workspaceSettingsModelFromTFEWorkspace(ws *tfe.Works
 }
 
 func (r *workspaceSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "workspace_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data modelWorkspaceSettings
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -416,7 +422,7 @@ func (r *workspaceSettings) readSettings(ctx context.Context, workspaceID string
 		return nil, fmt.Errorf("couldn't read workspace %s: %s", workspaceID, err.Error())
 	}
 
-	return r.workspaceSettingsModelFromTFEWorkspace(ws), nil
+	return r.workspaceSettingsModelFromTFEWorkspace(ctx, ws), nil
 }
 
 func (r *workspaceSettings) updateSettings(ctx context.Context, data *modelWorkspaceSettings, state *tfsdk.State) error {
@@ -449,7 +455,7 @@ func (r *workspaceSettings) updateSettings(ctx context.Context, data *modelWorks
 	}
 
 	if !data.GlobalRemoteState.ValueBool() {
-		r.addAndRemoveRemoteStateConsumers(workspaceID, data.RemoteStateConsumerIDs, state)
+		r.addAndRemoveRemoteStateConsumers(ctx, workspaceID, data.RemoteStateConsumerIDs, state)
 	}
 
 	model, err := r.readSettings(ctx, ws.ID)
@@ -460,7 +466,7 @@ func (r *workspaceSettings) updateSettings(ctx context.Context, data *modelWorks
 	return nil
 }
 
-func (r *workspaceSettings) addAndRemoveRemoteStateConsumers(workspaceID string, newWorkspaceIDsSet types.Set, state *tfsdk.State) error {
+func (r *workspaceSettings) addAndRemoveRemoteStateConsumers(ctx context.Context, workspaceID string, newWorkspaceIDsSet types.Set, state *tfsdk.State) error {
 	var oldWorkspaceIDsSet types.Set
 	diags := state.GetAttribute(ctx, path.Root("remote_state_consumer_ids"), &oldWorkspaceIDsSet)
 	if diags.HasError() {
@@ -531,6 +537,11 @@ func (r *workspaceSettings) addAndRemoveRemoteStateConsumers(workspaceID string,
 }
 
 func (r *workspaceSettings) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "workspace_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data modelWorkspaceSettings
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -540,6 +551,11 @@ func (r *workspaceSettings) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *workspaceSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.Plan, "workspace_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data modelWorkspaceSettings
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -549,6 +565,11 @@ func (r *workspaceSettings) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *workspaceSettings) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.config.useDataParentOrganization(ctx, req.State, "workspace_id")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data modelWorkspaceSettings
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -590,7 +611,12 @@ func (r *workspaceSettings) ImportState(ctx context.Context, req resource.Import
 			req.ID,
 		))
 	} else if len(s) == 2 {
-		workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], r.config.Client)
+		resp.Diagnostics.Append(r.config.useImportOrganization(s[0])...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		workspaceID, err := fetchWorkspaceExternalID(ctx, s[0]+"/"+s[1], r.config.Client)
 		if err != nil {
 			resp.Diagnostics.AddError("Error importing workspace settings", fmt.Sprintf(
 				"error retrieving workspace with name %s from organization %s: %s", s[1], s[0], err.Error(),
@@ -604,6 +630,114 @@ func (r *workspaceSettings) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// workspaceSettingsMoveSourceSchema is the subset of the tfe_workspace schema
+// (version 1) that tfe_workspace_settings takes over. Attributes not listed
+// here are ignored when the source state is decoded.
+var workspaceSettingsMoveSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"execution_mode": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"agent_pool_id": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"global_remote_state": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"remote_state_consumer_ids": schema.SetAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	},
+}
+
+// modelWorkspaceSettingsMoveSource maps workspaceSettingsMoveSourceSchema.
+type modelWorkspaceSettingsMoveSource struct {
+	ID                     types.String `tfsdk:"id"`
+	ExecutionMode          types.String `tfsdk:"execution_mode"`
+	AgentPoolID            types.String `tfsdk:"agent_pool_id"`
+	GlobalRemoteState      types.Bool   `tfsdk:"global_remote_state"`
+	RemoteStateConsumerIDs types.Set    `tfsdk:"remote_state_consumer_ids"`
+}
+
+// MoveState implements resource.ResourceWithMoveState. It allows a moved
+// block to hand the execution and remote state settings of a tfe_workspace
+// over to tfe_workspace_settings without changing anything in the workspace.
+func (r *workspaceSettings) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &workspaceSettingsMoveSourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "tfe_workspace" || !strings.HasSuffix(req.SourceProviderAddress, "hashicorp/tfe") {
+					return
+				}
+
+				if req.SourceSchemaVersion != 1 {
+					resp.Diagnostics.AddError(
+						"Unsupported tfe_workspace state",
+						fmt.Sprintf("Can't move tfe_workspace state with schema version %d to tfe_workspace_settings. Refresh the tfe_workspace with this version of the provider before moving it.", req.SourceSchemaVersion),
+					)
+					return
+				}
+
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to read tfe_workspace state",
+						"The tfe_workspace state could not be decoded. This is a bug in the tfe provider, so please report it on GitHub.",
+					)
+					return
+				}
+
+				var source modelWorkspaceSettingsMoveSource
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, workspaceSettingsModelFromMoveSource(source))...)
+			},
+		},
+	}
+}
+
+// workspaceSettingsModelFromMoveSource builds a resource model from the state of
+// a tfe_workspace, matching what workspaceSettingsModelFromTFEWorkspace would
+// read for the same workspace. Overwrites are not tracked by tfe_workspace and
+// are left null until the next refresh.
+func workspaceSettingsModelFromMoveSource(source modelWorkspaceSettingsMoveSource) modelWorkspaceSettings {
+	result := modelWorkspaceSettings{
+		ID:                     source.ID,
+		WorkspaceID:            source.ID,
+		ExecutionMode:          types.StringNull(),
+		AgentPoolID:            types.StringNull(),
+		Overwrites:             types.ListNull(overwritesElementType),
+		GlobalRemoteState:      types.BoolValue(source.GlobalRemoteState.ValueBool()),
+		RemoteStateConsumerIDs: types.SetNull(types.StringType),
+	}
+
+	// The SDK stores unset strings as empty strings
+	if source.ExecutionMode.ValueString() != "" {
+		result.ExecutionMode = source.ExecutionMode
+	}
+
+	if source.ExecutionMode.ValueString() == "agent" && source.AgentPoolID.ValueString() != "" {
+		result.AgentPoolID = source.AgentPoolID
+	}
+
+	if !source.GlobalRemoteState.ValueBool() {
+		result.RemoteStateConsumerIDs = types.SetValueMust(types.StringType, source.RemoteStateConsumerIDs.Elements())
+	}
+
+	return result
+}
+
 func NewResourceWorkspaceSettings() resource.Resource {
 	return &workspaceSettings{}
 }
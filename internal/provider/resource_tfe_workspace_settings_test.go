@@ -4,12 +4,15 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -321,3 +324,109 @@ resource "tfe_workspace_settings" "foobar" {
 }
 `, orgName, workspaceID)
 }
+
+func TestWorkspaceSettingsMoveState(t *testing.T) {
+	ctx := context.Background()
+	server, err := testAccMuxedProviders["tfe"]()
+	if err != nil {
+		t.Fatalf("Unexpected error creating the provider server: %v", err)
+	}
+
+	providerSchema, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil || len(providerSchema.Diagnostics) > 0 {
+		t.Fatalf("Unexpected error reading the provider schema: %v %v", err, providerSchema.Diagnostics)
+	}
+	targetType := providerSchema.ResourceSchemas["tfe_workspace_settings"].ValueType()
+
+	cases := map[string]struct {
+		sourceTypeName string
+		sourceVersion  int64
+		rawState       string
+		expected       map[string]tftypes.Value
+		expectError    string
+	}{
+		"agent execution with consumers": {
+			sourceTypeName: "tfe_workspace",
+			sourceVersion:  1,
+			rawState:       `{"id":"ws-abc123","name":"prod","organization":"hashicorp","execution_mode":"agent","agent_pool_id":"apool-123","global_remote_state":false,"remote_state_consumer_ids":["ws-def456"],"tag_names":[]}`,
+			expected: map[string]tftypes.Value{
+				"id":                  tftypes.NewValue(tftypes.String, "ws-abc123"),
+				"workspace_id":        tftypes.NewValue(tftypes.String, "ws-abc123"),
+				"execution_mode":      tftypes.NewValue(tftypes.String, "agent"),
+				"agent_pool_id":       tftypes.NewValue(tftypes.String, "apool-123"),
+				"global_remote_state": tftypes.NewValue(tftypes.Bool, false),
+				"remote_state_consumer_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "ws-def456"),
+				}),
+			},
+		},
+		"global remote state": {
+			sourceTypeName: "tfe_workspace",
+			sourceVersion:  1,
+			rawState:       `{"id":"ws-abc123","execution_mode":"remote","agent_pool_id":"","global_remote_state":true,"remote_state_consumer_ids":[]}`,
+			expected: map[string]tftypes.Value{
+				"id":                        tftypes.NewValue(tftypes.String, "ws-abc123"),
+				"workspace_id":              tftypes.NewValue(tftypes.String, "ws-abc123"),
+				"execution_mode":            tftypes.NewValue(tftypes.String, "remote"),
+				"agent_pool_id":             tftypes.NewValue(tftypes.String, nil),
+				"global_remote_state":       tftypes.NewValue(tftypes.Bool, true),
+				"remote_state_consumer_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			},
+		},
+		"old schema version": {
+			sourceTypeName: "tfe_workspace",
+			sourceVersion:  0,
+			rawState:       `{"id":"hashicorp/prod","external_id":"ws-abc123"}`,
+			expectError:    "Unsupported tfe_workspace state",
+		},
+		"unsupported source": {
+			sourceTypeName: "tfe_project",
+			sourceVersion:  0,
+			rawState:       `{"id":"prj-abc123"}`,
+			expectError:    "Unable to Move Resource State",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/tfe",
+				SourceTypeName:        tc.sourceTypeName,
+				SourceSchemaVersion:   tc.sourceVersion,
+				SourceState:           &tfprotov5.RawState{JSON: []byte(tc.rawState)},
+				TargetTypeName:        "tfe_workspace_settings",
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error moving state: %v", err)
+			}
+
+			if tc.expectError != "" {
+				if len(resp.Diagnostics) == 0 || resp.Diagnostics[0].Summary != tc.expectError {
+					t.Fatalf("Expected error %q, got %v", tc.expectError, resp.Diagnostics)
+				}
+				return
+			}
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			state, err := resp.TargetState.Unmarshal(targetType)
+			if err != nil {
+				t.Fatalf("Unexpected error reading the moved state: %v", err)
+			}
+			var attrs map[string]tftypes.Value
+			if err := state.As(&attrs); err != nil {
+				t.Fatal(err.Error())
+			}
+
+			for attr, expected := range tc.expected {
+				if !attrs[attr].Equal(expected) {
+					t.Errorf("Expected %s to be %s, got %s", attr, expected, attrs[attr])
+				}
+			}
+			if !attrs["overwrites"].IsNull() {
+				t.Errorf("Expected overwrites to be null, got %s", attrs["overwrites"])
+			}
+		})
+	}
+}
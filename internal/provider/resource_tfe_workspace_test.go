@@ -1414,6 +1414,71 @@ func TestAccTFEWorkspace_changeTags(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_defaultTags(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_defaultTags(rInt, "team:platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tag_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"tfe_workspace.foobar", "tag_names.*", "fav"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tags_all.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"tfe_workspace.foobar", "tags_all.*", "team:platform"),
+				),
+			},
+			{
+				// changing the default tags updates the workspace
+				Config: testAccTFEWorkspace_defaultTags(rInt, "team:payments"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tag_names.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tags_all.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"tfe_workspace.foobar", "tags_all.*", "team:payments"),
+				),
+			},
+		},
+	})
+}
+
+func TestTFEWorkspace_customizeDiffTagsAll(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tag_names": resourceTFEWorkspace().Schema["tag_names"],
+			"tags_all":  resourceTFEWorkspace().Schema["tags_all"],
+		},
+		CustomizeDiff: customizeDiffTagsAll,
+	}
+	config := ConfiguredClient{defaultTagNames: []string{"team:platform"}}
+
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tag_names": []interface{}{"fav"},
+	}), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := map[string]bool{}
+	for k, attr := range diff.Attributes {
+		if strings.HasPrefix(k, "tags_all.") && k != "tags_all.#" {
+			got[attr.New] = true
+		}
+	}
+	if len(got) != 2 || !got["fav"] || !got["team:platform"] {
+		t.Fatalf("expected tags_all to merge tag_names and the default tags, got %v", got)
+	}
+}
+
 func TestAccTFEWorkspace_updateSpeculative(t *testing.T) {
 	workspace := &tfe.Workspace{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -2245,20 +2310,20 @@ func TestTFEWorkspace_delete_withoutCanForceDeletePermission(t *testing.T) {
 		t.Fatalf("unexpected err creating configuration state %v", err)
 	}
 
-	err = resourceTFEWorkspaceDelete(rd, config)
-	if err == nil {
+	diags := resourceTFEWorkspaceDelete(ctx, rd, config)
+	if !diags.HasError() {
 		t.Fatalf("Expected an error deleting workspace with CanForceDelete=nil, force_delete=false, and %v resources", workspace.ResourceCount)
 	}
 
 	workspace.ResourceCount = 0
 
-	err = resourceTFEWorkspaceDelete(rd, config)
-	if err == nil {
+	diags = resourceTFEWorkspaceDelete(ctx, rd, config)
+	if !diags.HasError() {
 		t.Fatalf("Expected an error deleting workspace with CanForceDelete=nil and force_delete=false")
 	}
 	expectedErrSubstring := "This version of Terraform Enterprise does not support workspace safe-delete. Workspaces must be force deleted by setting force_delete=true"
-	if !strings.Contains(err.Error(), expectedErrSubstring) {
-		t.Fatalf("Expected error contains %s but got %s", expectedErrSubstring, err.Error())
+	if !strings.Contains(diags[0].Summary, expectedErrSubstring) {
+		t.Fatalf("Expected error contains %s but got %s", expectedErrSubstring, diags[0].Summary)
 	}
 
 	// now attempt with force_delete=true and confirm that it successfully removes the workspace
@@ -2267,9 +2332,9 @@ func TestTFEWorkspace_delete_withoutCanForceDeletePermission(t *testing.T) {
 		t.Fatalf("Unexpected err creating configuration state %v", err)
 	}
 
-	err = resourceTFEWorkspaceDelete(rd, config)
-	if err != nil {
-		t.Fatalf("Unexpected err deleting mock workspace %v", err)
+	diags = resourceTFEWorkspaceDelete(ctx, rd, config)
+	if diags.HasError() {
+		t.Fatalf("Unexpected err deleting mock workspace %v", diags)
 	}
 
 	workspace, err = client.Workspaces.ReadByID(ctx, workspace.ID)
@@ -2348,9 +2413,8 @@ func testAccCheckTFEWorkspacePanic(n string) resource.TestCheckFunc {
 		rd := &schema.ResourceData{}
 		rd.SetId(rs.Primary.ID)
 
-		err = resourceTFEWorkspaceRead(rd, testAccProvider.Meta())
-		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-			return fmt.Errorf("Could not re-read resource directly: %w", err)
+		if diags := resourceTFEWorkspaceRead(ctx, rd, testAccProvider.Meta()); diags.HasError() {
+			return fmt.Errorf("Could not re-read resource directly: %v", diags)
 		}
 
 		return nil
@@ -3028,6 +3092,26 @@ resource "tfe_workspace" "foobar" {
 }`, rInt)
 }
 
+func testAccTFEWorkspace_defaultTags(rInt int, defaultTag string) string {
+	return fmt.Sprintf(`
+provider "tfe" {
+  default_tags {
+    tag_names = [%q]
+  }
+}
+
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+  tag_names    = ["fav"]
+}`, defaultTag, rInt)
+}
+
 func testAccTFEWorkspace_ignoreAdditional(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
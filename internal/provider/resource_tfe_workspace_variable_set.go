@@ -16,14 +16,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEWorkspaceVariableSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspaceVariableSetCreate,
-		Read:   resourceTFEWorkspaceVariableSetRead,
-		Delete: resourceTFEWorkspaceVariableSetDelete,
+		CreateContext: resourceTFEWorkspaceVariableSetCreate,
+		ReadContext:   resourceTFEWorkspaceVariableSetRead,
+		DeleteContext: resourceTFEWorkspaceVariableSetDelete,
+		Timeouts:      resourceTimeouts(false),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspaceVariableSetImporter,
 		},
@@ -44,7 +46,7 @@ func resourceTFEWorkspaceVariableSet() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspaceVariableSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	vSID := d.Get("variable_set_id").(string)
@@ -55,17 +57,17 @@ func resourceTFEWorkspaceVariableSetCreate(d *schema.ResourceData, meta interfac
 
 	err := config.Client.VariableSets.ApplyToWorkspaces(ctx, vSID, &applyOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error applying variable set id %s to workspace %s: %w", vSID, wID, err)
+		return diag.Errorf(
+			"Error applying variable set id %s to workspace %s: %v", vSID, wID, err)
 	}
 
 	id := encodeVariableSetWorkspaceAttachment(wID, vSID)
 	d.SetId(id)
 
-	return resourceTFEWorkspaceVariableSetRead(d, meta)
+	return resourceTFEWorkspaceVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceVariableSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	wID := d.Get("workspace_id").(string)
@@ -81,7 +83,7 @@ func resourceTFEWorkspaceVariableSetRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of variable set %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of variable set %s: %v", d.Id(), err)
 	}
 
 	// Verify workspace listed in variable set
@@ -102,7 +104,7 @@ func resourceTFEWorkspaceVariableSetRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTFEWorkspaceVariableSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	wID := d.Get("workspace_id").(string)
@@ -114,8 +116,8 @@ func resourceTFEWorkspaceVariableSetDelete(d *schema.ResourceData, meta interfac
 
 	err := config.Client.VariableSets.RemoveFromWorkspaces(ctx, vSID, &removeOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error removing workspace %s from variable set %s: %w", wID, vSID, err)
+		return diag.Errorf(
+			"Error removing workspace %s from variable set %s: %v", wID, vSID, err)
 	}
 
 	return nil
@@ -4,13 +4,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
 )
 
 // fetchTerraformVersionID returns a Terraform Version ID for the given Terraform version number
-func fetchTerraformVersionID(version string, client *tfe.Client) (string, error) {
+func fetchTerraformVersionID(ctx context.Context, version string, client *tfe.Client) (string, error) {
 	versions, err := client.Admin.TerraformVersions.List(ctx, &tfe.AdminTerraformVersionsListOptions{
 		Filter: version,
 	})
@@ -53,7 +54,7 @@ func fetchTerraformVersionID(version string, client *tfe.Client) (string, error)
 }
 
 // fetchSentinelVersionID returns a Sentinel Version ID for the given Sentinel version number
-func fetchSentinelVersionID(version string, client *tfe.Client) (string, error) {
+func fetchSentinelVersionID(ctx context.Context, version string, client *tfe.Client) (string, error) {
 	versions, err := client.Admin.SentinelVersions.List(ctx, &tfe.AdminSentinelVersionsListOptions{
 		Filter: version,
 	})
@@ -96,7 +97,7 @@ func fetchSentinelVersionID(version string, client *tfe.Client) (string, error)
 }
 
 // fetchOPAVersionID returns a OPA Version ID for the given OPA version number
-func fetchOPAVersionID(version string, client *tfe.Client) (string, error) {
+func fetchOPAVersionID(ctx context.Context, version string, client *tfe.Client) (string, error) {
 	versions, err := client.Admin.OPAVersions.List(ctx, &tfe.AdminOPAVersionsListOptions{
 		Filter: version,
 	})
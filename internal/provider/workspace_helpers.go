@@ -13,7 +13,7 @@ import (
 
 // fetchWorkspaceExternalID returns the external id for a workspace
 // when given a workspace id of the form ORGANIZATION_AME/WORKSPACE_NAME
-func fetchWorkspaceExternalID(id string, client *tfe.Client) (string, error) {
+func fetchWorkspaceExternalID(ctx context.Context, id string, client *tfe.Client) (string, error) {
 	orgName, wsName, err := unpackWorkspaceID(id)
 	if err != nil {
 		return "", fmt.Errorf("Error unpacking workspace ID: %w", err)
@@ -33,7 +33,7 @@ type workspaceIDReader interface {
 
 // fetchWorkspaceHumanID returns the human readable id "org/workspace"
 // when given a workspace external id
-func fetchWorkspaceHumanID(id string, r workspaceIDReader) (string, error) {
+func fetchWorkspaceHumanID(ctx context.Context, id string, r workspaceIDReader) (string, error) {
 	workspace, err := r.ReadByID(ctx, id)
 	if err != nil {
 		return "", fmt.Errorf("Error reading configuration of workspace %s: %w", id, err)
@@ -69,7 +69,7 @@ func unpackWorkspaceID(id string) (organization, name string, err error) {
 	return s[0], s[1], nil
 }
 
-func readWorkspaceStateConsumers(id string, client *tfe.Client) (bool, []string, error) {
+func readWorkspaceStateConsumers(ctx context.Context, id string, client *tfe.Client) (bool, []string, error) {
 	options := &tfe.RemoteStateConsumersListOptions{ListOptions: tfe.ListOptions{PageSize: 100}}
 	remoteStateConsumerIDs := make([]string, 0)
 
@@ -41,7 +41,7 @@ func TestFetchWorkspaceExternalID(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := fetchWorkspaceExternalID(test.def, client)
+			got, err := fetchWorkspaceExternalID(ctx, test.def, client)
 
 			if (err != nil) != test.err {
 				t.Fatalf("expected error is %t, got %v", test.err, err)
@@ -91,7 +91,7 @@ func TestFetchWorkspaceHumanID(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := fetchWorkspaceHumanID(test.def, reader)
+			got, err := fetchWorkspaceHumanID(ctx, test.def, reader)
 
 			if (err != nil) != test.err {
 				t.Fatalf("expected error is %t, got %v", test.err, err)
@@ -216,7 +216,7 @@ func TestReadWorkspaceStateConsumers(t *testing.T) {
 			defaultWorkspaceID:           "ws-123",
 			remoteStateConsumersResponse: tc.remoteStateConsumersResponse,
 		})
-		actualGlobalRemoteState, actualRemoteStateConsumerIds, err := readWorkspaceStateConsumers("ws-123", client)
+		actualGlobalRemoteState, actualRemoteStateConsumerIds, err := readWorkspaceStateConsumers(ctx, "ws-123", client)
 		if (err != nil) != tc.err {
 			t.Fatalf("expected error is %t, got %v", tc.err, err)
 		}
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math"
@@ -14,7 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun bool, currentRetryAttempts int) error {
+func createWorkspaceRun(ctx context.Context, d *schema.ResourceData, meta interface{}, isDestroyRun bool, currentRetryAttempts int) error {
 	runArgs := getRunArgs(d, isDestroyRun)
 	if runArgs == nil {
 		return nil
@@ -49,7 +50,7 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 	waitForRun := runArgs["wait_for_run"].(bool)
 	manualConfirm := runArgs["manual_confirm"].(bool)
 
-	run, err := createRun(config.Client, waitForRun, manualConfirm, isDestroyRun, ws)
+	run, err := createRun(ctx, config.Client, waitForRun, manualConfirm, isDestroyRun, ws)
 	if err != nil {
 		return err
 	}
@@ -61,13 +62,13 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 	}
 
 	isPlanOp := true
-	hasPostPlanTaskStage, err := readPostPlanTaskStageInRun(config.Client, run.ID)
+	hasPostPlanTaskStage, err := readPostPlanTaskStageInRun(ctx, config.Client, run.ID)
 	if err != nil {
 		return err
 	}
 
 	planPendingStatuses, planTerminalStatuses := planStatuses(run, hasPostPlanTaskStage)
-	run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, planPendingStatuses, isPlanComplete(planTerminalStatuses))
+	run, err = awaitRun(ctx, config.Client, run.ID, ws.Organization.Name, isPlanOp, planPendingStatuses, isPlanComplete(planTerminalStatuses))
 	if err != nil {
 		return err
 	}
@@ -76,7 +77,7 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 		if retry && currentRetryAttempts < retryMaxAttempts {
 			currentRetryAttempts++
 			log.Printf("[INFO] Run errored during plan, retrying run, retry count: %d", currentRetryAttempts)
-			return createWorkspaceRun(d, meta, isDestroyRun, currentRetryAttempts)
+			return createWorkspaceRun(ctx, d, meta, isDestroyRun, currentRetryAttempts)
 		}
 
 		return fmt.Errorf("run errored during plan, use the run ID %s to debug error", run.ID)
@@ -84,14 +85,14 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 
 	if run.Status == tfe.RunPolicyOverride {
 		log.Printf("[INFO] Policy check soft-failed, awaiting manual override for run %q", run.ID)
-		run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, policyOverridePendingStatuses, isManuallyOverriden)
+		run, err = awaitRun(ctx, config.Client, run.ID, ws.Organization.Name, isPlanOp, policyOverridePendingStatuses, isManuallyOverriden)
 		if err != nil {
 			return err
 		}
 	}
 
 	if !run.HasChanges && !run.AllowEmptyApply {
-		run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatuses, isPlannedAndFinished)
+		run, err = awaitRun(ctx, config.Client, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatuses, isPlannedAndFinished)
 		if err != nil {
 			return err
 		}
@@ -104,23 +105,23 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 	}
 
 	// wait for run to be comfirmable before attempting to confirm
-	run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatuses, isConfirmable)
+	run, err = awaitRun(ctx, config.Client, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatuses, isConfirmable)
 	if err != nil {
 		return err
 	}
 
-	err = confirmRun(config.Client, manualConfirm, isPlanOp, run, ws)
+	err = confirmRun(ctx, config.Client, manualConfirm, isPlanOp, run, ws)
 	if err != nil {
 		return err
 	}
 
 	isPlanOp = false
-	run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, applyPendingStatuses, isCompleted)
+	run, err = awaitRun(ctx, config.Client, run.ID, ws.Organization.Name, isPlanOp, applyPendingStatuses, isCompleted)
 	if err != nil {
 		return err
 	}
 
-	return completeOrRetryRun(meta, run, d, retry, currentRetryAttempts, retryMaxAttempts, isDestroyRun)
+	return completeOrRetryRun(ctx, meta, run, d, retry, currentRetryAttempts, retryMaxAttempts, isDestroyRun)
 }
 
 func getRunArgs(d *schema.ResourceData, isDestroyRun bool) map[string]interface{} {
@@ -146,7 +147,7 @@ func getRunArgs(d *schema.ResourceData, isDestroyRun bool) map[string]interface{
 	return runArgs
 }
 
-func createRun(tfeClient *tfe.Client, waitForRun bool, manualConfirm bool, isDestroyRun bool, ws *tfe.Workspace) (*tfe.Run, error) {
+func createRun(ctx context.Context, tfeClient *tfe.Client, waitForRun bool, manualConfirm bool, isDestroyRun bool, ws *tfe.Workspace) (*tfe.Run, error) {
 	// In fire-and-forget mode (waitForRun=false), autoapply is set to !manualConfirm
 	// This should be intuitive, as "manual confirm" is the opposite of "auto apply"
 	//
@@ -182,20 +183,20 @@ func createRun(tfeClient *tfe.Client, waitForRun bool, manualConfirm bool, isDes
 	return run, nil
 }
 
-func confirmRun(tfeClient *tfe.Client, manualConfirm bool, isPlanOp bool, run *tfe.Run, ws *tfe.Workspace) error {
+func confirmRun(ctx context.Context, tfeClient *tfe.Client, manualConfirm bool, isPlanOp bool, run *tfe.Run, ws *tfe.Workspace) error {
 	// if human approval is required, an apply will auto kick off when run is manually approved
 	if manualConfirm {
 		confirmationPendingStatus := map[tfe.RunStatus]bool{}
 		confirmationPendingStatus[run.Status] = true
 
 		log.Printf("[INFO] Plan complete, waiting for manual confirm before proceeding run %q", run.ID)
-		_, err := awaitRun(tfeClient, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatus, isConfirmed)
+		_, err := awaitRun(ctx, tfeClient, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatus, isConfirmed)
 		if err != nil {
 			return err
 		}
 	} else {
 		// if human approval is NOT required, go ahead and kick off an apply
-		err := applyRun(tfeClient, run)
+		err := applyRun(ctx, tfeClient, run)
 		if err != nil {
 			return err
 		}
@@ -203,7 +204,7 @@ func confirmRun(tfeClient *tfe.Client, manualConfirm bool, isPlanOp bool, run *t
 	return nil
 }
 
-func applyRun(tfeClient *tfe.Client, run *tfe.Run) error {
+func applyRun(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run) error {
 	log.Printf("[INFO] Plan complete, confirming an apply for run %q", run.ID)
 	err := tfeClient.Runs.Apply(ctx, run.ID, tfe.RunApplyOptions{
 		Comment: tfe.String(fmt.Sprintf("Run confirmed by tfe_workspace_run resource via terraform-provider-tfe on %s",
@@ -220,7 +221,7 @@ func applyRun(tfeClient *tfe.Client, run *tfe.Run) error {
 	return nil
 }
 
-func completeOrRetryRun(meta interface{}, run *tfe.Run, d *schema.ResourceData, retry bool, currentRetryAttempts int, retryMaxAttempts int, isDestroyRun bool) error {
+func completeOrRetryRun(ctx context.Context, meta interface{}, run *tfe.Run, d *schema.ResourceData, retry bool, currentRetryAttempts int, retryMaxAttempts int, isDestroyRun bool) error {
 	switch run.Status {
 	case tfe.RunApplied:
 		log.Printf("[INFO] Apply complete for run %q", run.ID)
@@ -230,7 +231,7 @@ func completeOrRetryRun(meta interface{}, run *tfe.Run, d *schema.ResourceData,
 		if retry && currentRetryAttempts < retryMaxAttempts {
 			currentRetryAttempts++
 			log.Printf("[INFO] Run errored during apply, retrying run, retry count: %d", currentRetryAttempts)
-			return createWorkspaceRun(d, meta, isDestroyRun, currentRetryAttempts)
+			return createWorkspaceRun(ctx, d, meta, isDestroyRun, currentRetryAttempts)
 		}
 		return fmt.Errorf("run errored during apply, use the run ID %s to debug error", run.ID)
 	default:
@@ -239,7 +240,7 @@ func completeOrRetryRun(meta interface{}, run *tfe.Run, d *schema.ResourceData,
 	}
 }
 
-func awaitRun(tfeClient *tfe.Client, runID string, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool) (*tfe.Run, error) {
+func awaitRun(ctx context.Context, tfeClient *tfe.Client, runID string, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool) (*tfe.Run, error) {
 	for i := 0; ; i++ {
 		select {
 		case <-ctx.Done():
@@ -252,7 +253,7 @@ func awaitRun(tfeClient *tfe.Client, runID string, organization string, isPlanOp
 				continue
 			}
 
-			run, err = hasFinalStatus(tfeClient, run, organization, isPlanOp, runPendingStatus, isDone)
+			run, err = hasFinalStatus(ctx, tfeClient, run, organization, isPlanOp, runPendingStatus, isDone)
 			if run == nil && err == nil {
 				// if both error and run is nil, then run is still in progress
 				continue
@@ -263,7 +264,7 @@ func awaitRun(tfeClient *tfe.Client, runID string, organization string, isPlanOp
 	}
 }
 
-func hasFinalStatus(tfeClient *tfe.Client, run *tfe.Run, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool) (*tfe.Run, error) {
+func hasFinalStatus(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool) (*tfe.Run, error) {
 	_, runIsInProgress := runPendingStatus[run.Status]
 
 	switch {
@@ -271,7 +272,7 @@ func hasFinalStatus(tfeClient *tfe.Client, run *tfe.Run, organization string, is
 		log.Printf("[INFO] Run %s has reached a terminal state: %s", run.ID, run.Status)
 		return run, nil
 	case runIsInProgress:
-		logRunProgress(tfeClient, organization, isPlanOp, run)
+		logRunProgress(ctx, tfeClient, organization, isPlanOp, run)
 		return nil, nil
 	case run.Status == tfe.RunCanceled:
 		log.Printf("[INFO] Run %s has been canceled, status is %s", run.ID, run.Status)
@@ -282,7 +283,7 @@ func hasFinalStatus(tfeClient *tfe.Client, run *tfe.Run, organization string, is
 	}
 }
 
-func logRunProgress(tfeClient *tfe.Client, organization string, isPlanOp bool, run *tfe.Run) {
+func logRunProgress(ctx context.Context, tfeClient *tfe.Client, organization string, isPlanOp bool, run *tfe.Run) {
 	log.Printf("[DEBUG] Reading workspace %s", run.Workspace.ID)
 	ws, err := tfeClient.Workspaces.ReadByID(ctx, run.Workspace.ID)
 	if err != nil {
@@ -307,7 +308,7 @@ func logRunProgress(tfeClient *tfe.Client, organization string, isPlanOp bool, r
 
 	// if this run is the current run in it's workspace, display it's position in the organization queue
 	if ws.CurrentRun != nil && ws.CurrentRun.ID == run.ID {
-		runPositionInOrg, err := readRunPositionInOrgQueue(tfeClient, run.ID, organization)
+		runPositionInOrg, err := readRunPositionInOrgQueue(ctx, tfeClient, run.ID, organization)
 		if err != nil {
 			log.Printf("[ERROR] Unable to read run position in organization queue %v", err)
 			return
@@ -325,7 +326,7 @@ func logRunProgress(tfeClient *tfe.Client, organization string, isPlanOp bool, r
 	}
 
 	// if this run is not the current run in it's workspace, display it's position in the workspace queue
-	runPositionInWorkspace, err := readRunPositionInWorkspaceQueue(tfeClient, run.ID, ws.ID, isPlanOp, ws.CurrentRun)
+	runPositionInWorkspace, err := readRunPositionInWorkspaceQueue(ctx, tfeClient, run.ID, ws.ID, isPlanOp, ws.CurrentRun)
 	if err != nil {
 		log.Printf("[ERROR] Unable to read run position in workspace queue %v", err)
 		return
@@ -343,7 +344,7 @@ func logRunProgress(tfeClient *tfe.Client, organization string, isPlanOp bool, r
 	log.Printf("[INFO] Waiting for run %s, status is %s", run.ID, run.Status)
 }
 
-func readRunPositionInOrgQueue(tfeClient *tfe.Client, runID string, organization string) (int, error) {
+func readRunPositionInOrgQueue(ctx context.Context, tfeClient *tfe.Client, runID string, organization string) (int, error) {
 	position := 0
 	options := tfe.ReadRunQueueOptions{}
 
@@ -370,7 +371,7 @@ func readRunPositionInOrgQueue(tfeClient *tfe.Client, runID string, organization
 	return position, nil
 }
 
-func readRunPositionInWorkspaceQueue(tfeClient *tfe.Client, runID string, wsID string, isPlanOp bool, currentRun *tfe.Run) (int, error) {
+func readRunPositionInWorkspaceQueue(ctx context.Context, tfeClient *tfe.Client, runID string, wsID string, isPlanOp bool, currentRun *tfe.Run) (int, error) {
 	position := 0
 	options := tfe.RunListOptions{}
 	found := false
@@ -428,7 +429,7 @@ func backoff(min, max float64, iter int) time.Duration {
 	return time.Duration(backoff) * time.Millisecond
 }
 
-func readPostPlanTaskStageInRun(tfeClient *tfe.Client, runID string) (bool, error) {
+func readPostPlanTaskStageInRun(ctx context.Context, tfeClient *tfe.Client, runID string) (bool, error) {
 	hasPostPlanTaskStage := false
 	options := tfe.TaskStageListOptions{}
 
@@ -112,7 +112,7 @@ func TestReadRunPositionInWorkspaceQueue(t *testing.T) {
 
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
-			position, err := readRunPositionInWorkspaceQueue(
+			position, err := readRunPositionInWorkspaceQueue(ctx,
 				client,
 				testCase.currentRunID,
 				testCase.workspace,
@@ -222,7 +222,7 @@ func TestReadRunPositionInOrgQueue(t *testing.T) {
 
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
-			position, err := readRunPositionInOrgQueue(
+			position, err := readRunPositionInOrgQueue(ctx,
 				client,
 				"run-02",
 				testCase.orgName,
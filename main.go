@@ -10,8 +10,10 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 	"github.com/hashicorp/terraform-provider-tfe/internal/provider"
 )
 
@@ -26,6 +28,17 @@ func main() {
 	// prevent duplicate timestamp and incorrect log level setting
 	log.SetFlags(log.Flags() &^ (log.Ldate | log.Ltime))
 
+	// Subcommands are run directly rather than serving the provider, so they
+	// can be used without Terraform.
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "doctor":
+			os.Exit(runDoctor(os.Args[2:]))
+		case "export-organization":
+			os.Exit(runExportOrganization(ctx, os.Args[2:]))
+		}
+	}
+
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 
@@ -34,31 +47,42 @@ func main() {
 	if *debugFlag {
 		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
 	}
-	// terraform-plugin-mux here is used to combine multiple Terraform providers
-	// built using different SDK and frameworks in order to combine them into a
-	// single logical provider for Terraform to work with.
-	// - The classic provider relies on terraform-plugin-sdk, and has the bulk
-	//   of the resources and data sources.
-	// - The "next" provider relies on the newer terraform-plugin-framework, and
-	//   we expect to migrate resources and data sources to it over time.
-	// - The low-level provider relies on terraform-plugin-go to handle more
-	//   complex behavior, and should only be used for functionality that is not
-	//   available otherwise. We suspect the framework can supplant it, but have
-	//   not proven that out yet.
-	nextProvider := providerserver.NewProtocol5(provider.NewFrameworkProvider())
-	classicProvider := provider.Provider().GRPCProvider
-	lowLevelProvider := provider.PluginProviderServer
-	mux, err := tf5muxserver.NewMuxServer(
-		ctx, nextProvider, classicProvider, lowLevelProvider,
-	)
+	server, err := providerServer(ctx)
 	if err != nil {
 		log.Printf("[ERROR] Could not setup a mux server using the internal providers: %v", err)
 		os.Exit(1)
 	}
 
-	err = tf5server.Serve(tfeProviderName, mux.ProviderServer, serveOpts...)
+	// Plans are checked against read_only and deletion_protection once all
+	// servers are muxed, as they apply to the resources of every server.
+	err = tf5server.Serve(tfeProviderName, provider.WithDeletionProtection(provider.WithReadOnlyPlans(server)), serveOpts...)
+	client.ReportAPIMetrics()
 	if err != nil {
 		log.Printf("[ERROR] Could not start serving the ProviderServer: %v", err)
 		os.Exit(1)
 	}
 }
+
+// providerServer combines the providers built with each SDK into a single
+// logical provider for Terraform to work with, using terraform-plugin-mux:
+//   - The classic provider relies on terraform-plugin-sdk, and has the bulk
+//     of the resources and data sources.
+//   - The "next" provider relies on the newer terraform-plugin-framework, and
+//     we expect to migrate resources and data sources to it over time.
+//   - The low-level provider relies on terraform-plugin-go to handle more
+//     complex behavior, and should only be used for functionality that is not
+//     available otherwise. We suspect the framework can supplant it, but have
+//     not proven that out yet.
+func providerServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	nextProvider := providerserver.NewProtocol5(provider.NewFrameworkProvider())
+	classicProvider := provider.Provider().GRPCProvider
+	lowLevelProvider := provider.PluginProviderServer
+	mux, err := tf5muxserver.NewMuxServer(
+		ctx, nextProvider, classicProvider, lowLevelProvider,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mux.ProviderServer, nil
+}